@@ -0,0 +1,370 @@
+package kallax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+// ErrInvalidAggregateDest is returned when the destination given to
+// AggregateInto is not a pointer to a slice of structs or of pointers to
+// structs.
+var ErrInvalidAggregateDest = errors.New("kallax: aggregate destination must be a pointer to a slice of structs")
+
+// Aggregate is an aggregate function over a field, such as the sum of its
+// values. It is a SchemaField itself, so it can be used in the conditions
+// given to Having and in the order of the aggregating queries, and its name
+// is the one of its column in the results.
+type Aggregate struct {
+	fn       string
+	field    SchemaField
+	distinct bool
+	alias    string
+}
+
+// Sum returns the aggregate of the sum of the values of the given field.
+func Sum(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "SUM", field: field}
+}
+
+// Avg returns the aggregate of the average of the values of the given field.
+func Avg(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "AVG", field: field}
+}
+
+// Min returns the aggregate of the minimum value of the given field.
+func Min(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "MIN", field: field}
+}
+
+// Max returns the aggregate of the maximum value of the given field.
+func Max(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "MAX", field: field}
+}
+
+// Count returns the aggregate of the number of non-null values of the given
+// field.
+func Count(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "COUNT", field: field}
+}
+
+// CountDistinct returns the aggregate of the number of distinct non-null
+// values of the given field.
+func CountDistinct(field SchemaField) *Aggregate {
+	return &Aggregate{fn: "COUNT", field: field, distinct: true}
+}
+
+// As returns a copy of the aggregate with the given name, which is the one
+// of its column in the results. By default, it is the name of the function
+// followed by the name of the field, e.g. sum_price or count_distinct_email.
+func (a *Aggregate) As(name string) *Aggregate {
+	agg := *a
+	agg.alias = name
+	return &agg
+}
+
+func (*Aggregate) isSchemaField() {}
+
+// String returns the name of the aggregate column.
+func (a *Aggregate) String() string {
+	if a.alias != "" {
+		return a.alias
+	}
+
+	name := strings.ToLower(a.fn)
+	if a.distinct {
+		name += "_distinct"
+	}
+	return name + "_" + a.field.String()
+}
+
+// QualifiedName returns the SQL expression of the aggregate over the field
+// qualified by the alias of the given schema.
+func (a *Aggregate) QualifiedName(schema Schema) string {
+	var distinct string
+	if a.distinct {
+		distinct = "DISTINCT "
+	}
+	return fmt.Sprintf("%s(%s%s)", a.fn, distinct, a.field.QualifiedName(schema))
+}
+
+// AggregateRow is a row of the results of an aggregation, with the values of
+// the fields it is grouped by and of its aggregates by their names. The
+// values are the ones returned by the database driver, and can be converted
+// with the typed getters of the row.
+type AggregateRow map[string]interface{}
+
+// Value returns the value of the given field or aggregate in the row.
+func (r AggregateRow) Value(field SchemaField) interface{} {
+	return r[field.String()]
+}
+
+// IsNull reports whether the value of the given field or aggregate in the
+// row is NULL.
+func (r AggregateRow) IsNull(field SchemaField) bool {
+	return r.Value(field) == nil
+}
+
+// Int64 returns the value of the given field or aggregate in the row as an
+// int64, or zero if it is NULL.
+func (r AggregateRow) Int64(field SchemaField) (int64, error) {
+	switch v := r.Value(field).(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("kallax: value of %s is not an integer: %v", field, v)
+		}
+		return int64(v), nil
+	case []byte, string:
+		s := asString(v)
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+
+		// numeric values may have trailing zero decimals
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || f != math.Trunc(f) {
+			return 0, fmt.Errorf("kallax: value of %s is not an integer: %s", field, s)
+		}
+		return int64(f), nil
+	default:
+		return 0, fmt.Errorf("kallax: can't convert value of %s of type %T to int64", field, v)
+	}
+}
+
+// Float64 returns the value of the given field or aggregate in the row as a
+// float64, or zero if it is NULL.
+func (r AggregateRow) Float64(field SchemaField) (float64, error) {
+	switch v := r.Value(field).(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case []byte, string:
+		f, err := strconv.ParseFloat(asString(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("kallax: value of %s is not a number: %s", field, asString(v))
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("kallax: can't convert value of %s of type %T to float64", field, v)
+	}
+}
+
+// String returns the value of the given field or aggregate in the row as a
+// string, or an empty string if it is NULL.
+func (r AggregateRow) String(field SchemaField) string {
+	switch v := r.Value(field).(type) {
+	case nil:
+		return ""
+	case []byte, string:
+		return asString(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Time returns the value of the given field or aggregate in the row as a
+// time, or the zero time if it is NULL.
+func (r AggregateRow) Time(field SchemaField) (time.Time, error) {
+	switch v := r.Value(field).(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	default:
+		return time.Time{}, fmt.Errorf("kallax: can't convert value of %s of type %T to time", field, v)
+	}
+}
+
+func asString(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v.(string)
+}
+
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group of rows with the same values of the fields the
+// query is grouped by, or a single row if it is not grouped. Every row
+// contains the values of the group by fields and the aggregates. The groups
+// can be filtered with the conditions given to the Having method of the
+// query, and sorted by its order.
+func (s *Store) Aggregate(q Query, aggregates ...*Aggregate) ([]AggregateRow, error) {
+	return s.AggregateContext(context.Background(), q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context. See Aggregate for more details.
+func (s *Store) AggregateContext(ctx context.Context, q Query, aggregates ...*Aggregate) (result []AggregateRow, err error) {
+	err = s.aggregate(ctx, q, aggregates, func(columns []string, scan func(...interface{}) error) error {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+
+		if err := scan(ptrs...); err != nil {
+			return err
+		}
+
+		row := make(AggregateRow, len(columns))
+		for i, col := range columns {
+			row[col] = values[i]
+		}
+		result = append(result, row)
+		return nil
+	})
+	return result, err
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given destination, which must be a pointer to a slice
+// of structs, or of pointers to structs, with a field for every field the
+// query is grouped by and every aggregate. The fields are matched by the
+// name in their kallax or column tag or, if they have none, by their name
+// ignoring case and underscores, e.g. the SumPrice field for the sum_price
+// aggregate.
+// The values are scanned into the fields the same way database/sql does, so
+// the fields of values that can be NULL must be pointers or sql.Null types.
+// See Aggregate for more details.
+func (s *Store) AggregateInto(q Query, dest interface{}, aggregates ...*Aggregate) error {
+	return s.AggregateIntoContext(context.Background(), q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given destination using the given context. See
+// AggregateInto for more details.
+func (s *Store) AggregateIntoContext(ctx context.Context, q Query, dest interface{}, aggregates ...*Aggregate) error {
+	slice, ok := newStructSlice(dest)
+	if !ok {
+		return ErrInvalidAggregateDest
+	}
+	return s.aggregate(ctx, q, aggregates, slice.scan)
+}
+
+// AggregateValue stores the value of the given aggregate over the rows
+// selected by the given query in the given destination, which must be a
+// pointer to a basic type, a time or a sql.Scanner. The destination is left
+// untouched if the value is NULL, e.g. if there are no rows. If the query is
+// grouped, the value of the first group is stored.
+func (s *Store) AggregateValue(q Query, aggregate *Aggregate, dest interface{}) error {
+	return s.AggregateValueContext(context.Background(), q, aggregate, dest)
+}
+
+// AggregateValueContext stores the value of the given aggregate over the rows
+// selected by the given query in the given destination using the given
+// context. See AggregateValue for more details.
+func (s *Store) AggregateValueContext(ctx context.Context, q Query, aggregate *Aggregate, dest interface{}) error {
+	var scanned bool
+	return s.aggregate(ctx, q, []*Aggregate{aggregate}, func(columns []string, scan func(...interface{}) error) error {
+		if scanned {
+			return nil
+		}
+		scanned = true
+
+		// the group by fields come first
+		ptrs := make([]interface{}, len(columns))
+		for i := range ptrs {
+			ptrs[i] = new(interface{})
+		}
+		ptrs[len(ptrs)-1] = types.Nullable(dest)
+		return scan(ptrs...)
+	})
+}
+
+// aggregate runs the query of the given aggregates and calls the given
+// function for every row with the names of the columns and the function to
+// scan them.
+func (s *Store) aggregate(ctx context.Context, q Query, aggregates []*Aggregate, fn func([]string, func(...interface{}) error) error) (err error) {
+	ctx, span := s.startOperation(ctx, AggregateOperation, q.Schema().Table())
+	defer func() { span.Finish(err) }()
+
+	columns, builder := q.compileAggregate(s.dialect, aggregates)
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	// the values are scanned generically, so they are read with database/sql
+	rows, err := s.proxy.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(columns, rows.Scan); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// compileAggregate returns the names of the columns and the select builder
+// of the given aggregates over the query for the given dialect, grouped by
+// the group by fields of the query, which are selected first.
+func (q *BaseQuery) compileAggregate(dialect Dialect, aggregates []*Aggregate) ([]string, squirrel.SelectBuilder) {
+	schema := withDialect(q.schema, dialect)
+	var (
+		columns = make([]string, 0, len(q.groupBy)+len(aggregates))
+		exprs   = make([]string, 0, len(q.groupBy)+len(aggregates))
+		groupBy = make([]string, len(q.groupBy))
+	)
+
+	for i, f := range q.groupBy {
+		groupBy[i] = f.QualifiedName(schema)
+		columns = append(columns, f.String())
+		exprs = append(exprs, groupBy[i])
+	}
+
+	for _, a := range aggregates {
+		columns = append(columns, a.String())
+		exprs = append(exprs, fmt.Sprintf("%s AS %s", a.QualifiedName(schema), dialect.QuoteIdentifier(a.String())))
+	}
+
+	builder := q.builder.PlaceholderFormat(dialect.PlaceholderFormat())
+	for _, cond := range q.getConditions(dialect) {
+		builder = builder.Where(cond)
+	}
+
+	if len(groupBy) > 0 {
+		builder = builder.GroupBy(groupBy...)
+	}
+
+	for _, cond := range q.having {
+		builder = builder.Having(cond(schema))
+	}
+
+	if len(q.orders) > 0 {
+		var orders = make([]string, len(q.orders))
+		for i, o := range q.orders {
+			orders[i] = o.ToSql(schema)
+		}
+		builder = builder.OrderBy(orders...)
+	}
+
+	return columns, builder.Columns(exprs...)
+}
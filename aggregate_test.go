@@ -0,0 +1,115 @@
+package kallax
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregate(t *testing.T) {
+	require := require.New(t)
+	cases := []struct {
+		aggregate *Aggregate
+		name      string
+		sql       string
+	}{
+		{Sum(f("age")), "sum_age", "SUM(__model.age)"},
+		{Avg(f("age")), "avg_age", "AVG(__model.age)"},
+		{Min(f("age")), "min_age", "MIN(__model.age)"},
+		{Max(f("age")), "max_age", "MAX(__model.age)"},
+		{Count(f("email")), "count_email", "COUNT(__model.email)"},
+		{CountDistinct(f("email")), "count_distinct_email", "COUNT(DISTINCT __model.email)"},
+		{Sum(f("age")).As("total"), "total", "SUM(__model.age)"},
+	}
+
+	for _, c := range cases {
+		require.Equal(c.name, c.aggregate.String())
+		require.Equal(c.sql, c.aggregate.QualifiedName(ModelSchema))
+	}
+}
+
+func TestBaseQuery_CompileAggregate(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 1))
+	q.GroupBy(f("email"))
+	q.Having(Gt(Sum(f("age")), 10))
+	q.Order(Desc(Sum(f("age"))))
+
+	columns, builder := q.compileAggregate(PostgreSQL, []*Aggregate{Sum(f("age")), CountDistinct(f("name")).As("names")})
+	sql, args, err := builder.ToSql()
+	require.NoError(err)
+	require.Equal([]string{"email", "sum_age", "names"}, columns)
+	require.Equal(
+		`SELECT __model.email, SUM(__model.age) AS "sum_age", COUNT(DISTINCT __model.name) AS "names" `+
+			"FROM model __model WHERE __model.age > $1 GROUP BY __model.email HAVING SUM(__model.age) > $2 "+
+			"ORDER BY SUM(__model.age) DESC",
+		sql,
+	)
+	require.Equal([]interface{}{1, 10}, args)
+
+	copy := q.Copy()
+	require.Equal(q.groupBy, copy.groupBy)
+	require.Len(copy.having, 1)
+}
+
+func TestAggregateRow(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	row := AggregateRow{
+		"int":     int64(42),
+		"float":   1.5,
+		"numeric": []byte("12.000"),
+		"decimal": "2.5",
+		"null":    nil,
+		"time":    now,
+	}
+
+	n, err := row.Int64(f("int"))
+	require.NoError(err)
+	require.Equal(int64(42), n)
+
+	n, err = row.Int64(f("numeric"))
+	require.NoError(err)
+	require.Equal(int64(12), n)
+
+	_, err = row.Int64(f("decimal"))
+	require.Error(err)
+
+	_, err = row.Int64(f("float"))
+	require.Error(err)
+
+	n, err = row.Int64(f("null"))
+	require.NoError(err)
+	require.Equal(int64(0), n)
+
+	x, err := row.Float64(f("decimal"))
+	require.NoError(err)
+	require.Equal(2.5, x)
+
+	x, err = row.Float64(f("int"))
+	require.NoError(err)
+	require.Equal(42.0, x)
+
+	_, err = row.Float64(f("time"))
+	require.Error(err)
+
+	tm, err := row.Time(f("time"))
+	require.NoError(err)
+	require.Equal(now, tm)
+
+	require.Equal("12.000", row.String(f("numeric")))
+	require.Equal("42", row.String(f("int")))
+	require.True(row.IsNull(f("null")))
+	require.False(row.IsNull(f("int")))
+}
+
+func TestAggregateInto_InvalidDest(t *testing.T) {
+	store := (&Store{runner: &fakeProxy{}}).build()
+	q := NewBaseQuery(ModelSchema)
+	for _, dest := range []interface{}{nil, []struct{}{}, &[]int{}, new(struct{})} {
+		require.Equal(t, ErrInvalidAggregateDest, store.AggregateInto(q, dest, Sum(f("age"))))
+	}
+}
@@ -1,31 +1,48 @@
 package kallax
 
 import (
-	"database/sql"
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lann/builder"
 )
 
 type batchQueryRunner struct {
+	ctx           context.Context
 	schema        Schema
 	cols          []string
 	q             Query
 	oneToOneRels  []Relationship
 	oneToManyRels []Relationship
-	db            squirrel.DBProxy
+	db            dbProxy
+	dialect       Dialect
 	builder       squirrel.SelectBuilder
 	total         int
 	eof           bool
+	// batches is the number of batches loaded so far.
+	batches int
+	tracer  Tracer
+	metrics MetricsSink
 	// records is the cache of the records in the last batch.
 	records []Record
+	// cursor is the server-side cursor the batches are fetched from, if the
+	// query uses one.
+	cursor *cursor
+	// keyset are the columns that determine the position of the rows in the
+	// order of the query, if it is ordered.
+	keyset []keysetColumn
+	// lastValues are the values of the keyset columns in the last row of the
+	// last batch, from which the next batch starts instead of using an
+	// offset, if the query is ordered.
+	lastValues []interface{}
 }
 
 var errNoMoreRows = errors.New("kallax: there are no more rows in the result set")
 
-func newBatchQueryRunner(schema Schema, db squirrel.DBProxy, q Query) *batchQueryRunner {
-	cols, builder := q.compile()
+func newBatchQueryRunner(ctx context.Context, schema Schema, db dbProxy, q Query, dialect Dialect) *batchQueryRunner {
+	cols, builder := q.compile(dialect)
 	var (
 		oneToOneRels  []Relationship
 		oneToManyRels []Relationship
@@ -41,13 +58,16 @@ func newBatchQueryRunner(schema Schema, db squirrel.DBProxy, q Query) *batchQuer
 	}
 
 	return &batchQueryRunner{
+		ctx:           ctx,
 		schema:        schema,
 		cols:          cols,
 		q:             q,
 		oneToOneRels:  oneToOneRels,
 		oneToManyRels: oneToManyRels,
 		db:            db,
+		dialect:       dialect,
 		builder:       builder,
+		keyset:        q.keyset(),
 	}
 }
 
@@ -66,12 +86,19 @@ func (r *batchQueryRunner) next() (Record, error) {
 		if limit <= 0 || limit > uint64(r.total) {
 			records, err = r.loadNextBatch()
 			if err != nil {
+				// the iteration ends with the first error, so the cursor
+				// and its transaction are not left open
+				r.eof = true
+				r.closeCursor(true)
 				return nil, err
 			}
 		}
 
 		if len(records) == 0 {
 			r.eof = true
+			// the cursor is released as soon as all the rows are fetched,
+			// the error closing it is returned when the result set is closed.
+			r.close()
 			return nil, errNoMoreRows
 		}
 
@@ -85,32 +112,110 @@ func (r *batchQueryRunner) next() (Record, error) {
 	return record, nil
 }
 
-func (r *batchQueryRunner) loadNextBatch() ([]Record, error) {
+func (r *batchQueryRunner) loadNextBatch() (records []Record, err error) {
+	// No more batches are loaded once the context is done, even if there
+	// are still rows left to retrieve.
+	if err := r.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// the rows of reversed queries are retrieved in a single batch, as they
+	// can only be reversed back all at once
 	limit := r.q.GetLimit() - uint64(r.total)
-	if r.q.GetBatchSize() < limit || limit <= 0 {
+	if !r.q.isReversed() && (r.q.GetBatchSize() < limit || limit <= 0) {
 		limit = r.q.GetBatchSize()
 	}
 
-	rows, err := r.builder.
-		Offset(r.q.GetOffset() + uint64(r.total)).
-		Limit(limit).
-		RunWith(r.db).
-		Query()
+	r.batches++
+	ctx, span := startSpan(r.ctx, r.tracer, "kallax.batch", FindOperation, r.schema.Table())
+	span.SetTag("db.batch", r.batches)
+	defer func() {
+		span.SetTag("db.rows", len(records))
+		span.Finish(err)
+	}()
+
+	ctx, hooks := withRowsHooks(ctx)
+	var rows resultRows
+	switch {
+	case r.cursor != nil:
+		rows, err = r.cursor.fetch(ctx, limit)
+	case r.lastValues != nil:
+		// keyset iteration, the batch starts right after the last row
+		cond := keysetCondition(r.keyset, r.lastValues, false)
+		rows, err = querySelect(ctx, r.db, r.keysetBuilder().
+			Where(cond(withDialect(r.schema, r.dialect))).
+			Limit(limit))
+	default:
+		rows, err = querySelect(ctx, r.db, r.keysetBuilder().
+			Offset(r.q.GetOffset()+uint64(r.total)).
+			Limit(limit))
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	return r.processBatch(rows)
+	reportBatch(r.metrics, r.schema.Table())
+	records, err = r.processBatch(ctx, rows, hooks)
+	if err != nil || len(records) == 0 {
+		return records, err
+	}
+
+	if r.q.isReversed() {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	} else if r.cursor == nil && r.keyset != nil {
+		// the offset is used instead if the keyset columns are not selected
+		r.lastValues, _ = keysetValues(records[len(records)-1], r.keyset, r.cols)
+	}
+
+	return records, nil
+}
+
+// keysetBuilder returns the builder of the query ordered by its keyset
+// columns, so the rows with the same values in the columns it is ordered by
+// keep the same order across batches. The builder is returned as is if the
+// query is not ordered.
+func (r *batchQueryRunner) keysetBuilder() squirrel.SelectBuilder {
+	if r.keyset == nil {
+		return r.builder
+	}
+
+	b := builder.Set(r.builder, "OrderBys", nil).(squirrel.SelectBuilder)
+	return b.OrderBy(keysetOrders(withDialect(r.schema, r.dialect), r.keyset, r.q.isReversed())...)
 }
 
-func (r *batchQueryRunner) processBatch(rows *sql.Rows) ([]Record, error) {
-	batchRs := NewResultSet(
+// close closes the cursor of the runner, if any.
+func (r *batchQueryRunner) close() error {
+	return r.closeCursor(false)
+}
+
+// closeCursor closes the cursor of the runner, if any, rolling back its
+// transaction if failed is true. The cursor is still closed if the context
+// of the runner is done.
+func (r *batchQueryRunner) closeCursor(failed bool) error {
+	if r.cursor == nil {
+		return nil
+	}
+
+	ctx := r.ctx
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+	return r.cursor.close(ctx, failed)
+}
+
+func (r *batchQueryRunner) processBatch(ctx context.Context, rows resultRows, hooks *rowsHooks) ([]Record, error) {
+	batchRs := newResultSet(
 		rows,
 		r.q.isReadOnly(),
 		r.oneToOneRels,
 		r.cols...,
 	)
+	batchRs.hooks = hooks
+	batchRs.dialect = r.dialect
+	reportRowsScanned(r.metrics, r.schema.Table(), batchRs)
 
 	var records []Record
 	for batchRs.Next() {
@@ -131,7 +236,7 @@ func (r *batchQueryRunner) processBatch(rows *sql.Rows) ([]Record, error) {
 	}
 
 	for _, rel := range r.oneToManyRels {
-		indexedResults, err := r.getRecordRelationships(ids, rel)
+		indexedResults, err := r.getRecordRelationships(ctx, ids, rel)
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +261,7 @@ func (r *batchQueryRunner) processBatch(rows *sql.Rows) ([]Record, error) {
 
 type indexedRecords map[interface{}][]Record
 
-func (r *batchQueryRunner) getRecordRelationships(ids []interface{}, rel Relationship) (indexedRecords, error) {
+func (r *batchQueryRunner) getRecordRelationships(ctx context.Context, ids []interface{}, rel Relationship) (_ indexedRecords, err error) {
 	fk, ok := r.schema.ForeignKey(rel.Field)
 	if !ok {
 		return nil, fmt.Errorf("kallax: cannot find foreign key on field %s for table %s", rel.Field, r.schema.Table())
@@ -169,15 +274,24 @@ func (r *batchQueryRunner) getRecordRelationships(ids []interface{}, rel Relatio
 		rel.Filter = filter
 	}
 
+	// soft deleted records are never loaded as relationships, no matter the
+	// scope of the parent query
 	q := NewBaseQuery(rel.Schema)
 	q.Where(rel.Filter)
-	cols, builder := q.compile()
-	rows, err := builder.RunWith(r.db).Query()
+	cols, builder := q.compile(r.dialect)
+	ctx, span := startSpan(ctx, r.tracer, "kallax.find", FindOperation, rel.Schema.Table())
+	defer func() { span.Finish(err) }()
+
+	ctx, hooks := withRowsHooks(ctx)
+	rows, err := querySelect(ctx, r.db, builder)
 	if err != nil {
 		return nil, err
 	}
 
-	relRs := NewResultSet(rows, false, nil, cols...)
+	relRs := newResultSet(rows, false, nil, cols...)
+	relRs.hooks = hooks
+	relRs.dialect = r.dialect
+	reportRowsScanned(r.metrics, rel.Schema.Table(), relRs)
 	var indexedResults = make(indexedRecords)
 	for relRs.Next() {
 		rec, err := relRs.Get(rel.Schema)
@@ -1,10 +1,10 @@
 package kallax
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
-	"github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,7 +16,7 @@ func TestOneToManyWithFilterNotWritable(t *testing.T) {
 	defer db.Close()
 	defer teardownTables(t, db)
 
-	store := NewStore(db)
+	store := newTestStore(db)
 	m := newModel("foo", "bar", 1)
 	r.NoError(store.Insert(ModelSchema, m))
 
@@ -26,7 +26,7 @@ func TestOneToManyWithFilterNotWritable(t *testing.T) {
 
 	q := NewBaseQuery(ModelSchema)
 	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, Eq(f("foo"), "1")))
-	runner := newBatchQueryRunner(ModelSchema, squirrel.NewStmtCacher(db), q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, newStmtCacher(db), q, PostgreSQL)
 	record, err := runner.next()
 	r.NoError(err)
 	r.False(record.IsWritable())
@@ -40,7 +40,7 @@ func TestBatcherLimit(t *testing.T) {
 	defer db.Close()
 	defer teardownTables(t, db)
 
-	store := NewStore(db)
+	store := newTestStore(db)
 	for i := 0; i < 10; i++ {
 		m := newModel("foo", "bar", 1)
 		r.NoError(store.Insert(ModelSchema, m))
@@ -54,7 +54,7 @@ func TestBatcherLimit(t *testing.T) {
 	q.BatchSize(2)
 	q.Limit(5)
 	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, Eq(f("foo"), "1")))
-	runner := newBatchQueryRunner(ModelSchema, squirrel.NewStmtCacher(db), q)
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, newStmtCacher(db), q, PostgreSQL)
 	rs := NewBatchingResultSet(runner)
 
 	var count int
@@ -66,3 +66,133 @@ func TestBatcherLimit(t *testing.T) {
 	r.NoError(err)
 	r.Equal(5, count)
 }
+
+func TestBatcherKeyset(t *testing.T) {
+	r := require.New(t)
+	db, err := openTestDB()
+	r.NoError(err)
+	setupTables(t, db)
+	defer db.Close()
+	defer teardownTables(t, db)
+
+	store := newTestStore(db)
+	for i := 0; i < 9; i++ {
+		m := newModel(fmt.Sprint(i), "bar", i/2)
+		r.NoError(store.Insert(ModelSchema, m))
+		r.NoError(store.Insert(RelSchema, newRel(m.GetID(), fmt.Sprint(i))))
+	}
+
+	var queries []string
+	proxy := &debugProxy{func(msg string, _ ...interface{}) {
+		queries = append(queries, msg)
+	}, newStmtCacher(db)}
+
+	q := NewBaseQuery(ModelSchema)
+	q.BatchSize(2)
+	q.Order(Desc(f("age")))
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	runner := newBatchQueryRunner(context.Background(), ModelSchema, proxy, q, PostgreSQL)
+	rs := NewBatchingResultSet(runner)
+
+	var names []string
+	for rs.Next() {
+		record, err := rs.Get(nil)
+		r.NoError(err)
+		r.Len(record.(*model).Rels, 1)
+		names = append(names, record.(*model).Name)
+	}
+	r.Equal([]string{"8", "6", "7", "4", "5", "2", "3", "0", "1"}, names)
+
+	for _, q := range queries {
+		r.NotContains(q, "OFFSET")
+	}
+}
+
+func TestBatcherTracing(t *testing.T) {
+	r := require.New(t)
+	db, err := openTestDB()
+	r.NoError(err)
+	setupTables(t, db)
+	defer db.Close()
+	defer teardownTables(t, db)
+
+	store := newTestStore(db)
+	for i := 0; i < 3; i++ {
+		m := newModel("foo", "bar", 1)
+		r.NoError(store.Insert(ModelSchema, m))
+		r.NoError(store.Insert(RelSchema, newRel(m.GetID(), fmt.Sprint(i))))
+	}
+
+	tracer := new(fakeTracer)
+	q := NewBaseQuery(ModelSchema)
+	q.BatchSize(2)
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	rs, err := store.WithTracer(tracer).Find(q)
+	r.NoError(err)
+	for rs.Next() {
+		_, err := rs.Get(nil)
+		r.NoError(err)
+	}
+
+	// 2 batches with records and an empty one, with a relationship query
+	// each
+	r.Len(tracer.spans, 6)
+	for i, batch := range []int{1, 2, 3} {
+		span := tracer.spans[i*2]
+		r.Equal("kallax.batch", span.name)
+		r.Equal(batch, span.tags["db.batch"])
+		r.Equal("model", span.tags["db.table"])
+		r.True(span.finished)
+
+		rel := tracer.spans[i*2+1]
+		r.Equal("kallax.find", rel.name)
+		r.Equal(span, rel.parent)
+		r.Equal("rel", rel.tags["db.table"])
+	}
+}
+
+func TestBatcherMetrics(t *testing.T) {
+	r := require.New(t)
+	db, err := openTestDB()
+	r.NoError(err)
+	setupTables(t, db)
+	defer db.Close()
+	defer teardownTables(t, db)
+
+	store := newTestStore(db)
+	for i := 0; i < 3; i++ {
+		m := newModel("foo", "bar", 1)
+		r.NoError(store.Insert(ModelSchema, m))
+		r.NoError(store.Insert(RelSchema, newRel(m.GetID(), fmt.Sprint(i))))
+	}
+
+	sink := newFakeSink()
+	q := NewBaseQuery(ModelSchema)
+	q.BatchSize(2)
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	rs, err := store.WithMetrics(sink).Find(q)
+	r.NoError(err)
+	for rs.Next() {
+		_, err := rs.Get(nil)
+		r.NoError(err)
+	}
+
+	r.Equal(int64(3), sink.counters["kallax_batches_total,table=model"])
+	r.Equal(int64(3), sink.counters["kallax_rows_scanned_total,table=model"])
+	r.Equal(int64(3), sink.counters["kallax_rows_scanned_total,table=rel"])
+}
+
+func TestBatcherContextCancelled(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	q := NewBaseQuery(ModelSchema)
+	r.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	rs := NewBatchingResultSet(newBatchQueryRunner(ctx, ModelSchema, nil, q, PostgreSQL))
+
+	r.True(rs.Next())
+	_, err := rs.Get(nil)
+	r.Equal(context.Canceled, err)
+	r.False(rs.Next(), "no more batches should be loaded")
+}
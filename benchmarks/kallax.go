@@ -5,8 +5,10 @@
 package benchmark
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"io"
 
 	"gopkg.in/src-d/go-kallax.v1"
 	"gopkg.in/src-d/go-kallax.v1/types"
@@ -97,6 +99,12 @@ func NewPersonStore(db *sql.DB) *PersonStore {
 	return &PersonStore{kallax.NewStore(db)}
 }
 
+// NewPersonStoreWithDialect creates a new instance of PersonStore
+// using a SQL database of the given dialect.
+func NewPersonStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *PersonStore {
+	return &PersonStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *PersonStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -119,6 +127,36 @@ func (s *PersonStore) DebugWith(logger kallax.LoggerFunc) *PersonStore {
 	return &PersonStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *PersonStore) WithInterceptors(interceptors ...kallax.Interceptor) *PersonStore {
+	return &PersonStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *PersonStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *PersonStore {
+	return &PersonStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *PersonStore) WithTracer(tracer kallax.Tracer) *PersonStore {
+	return &PersonStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *PersonStore) WithMetrics(sink kallax.MetricsSink) *PersonStore {
+	return &PersonStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *PersonStore) WithRetry(policy kallax.RetryPolicy) *PersonStore {
+	return &PersonStore{s.Store.WithRetry(policy)}
+}
+
 func (s *PersonStore) relationshipRecords(record *Person) []kallax.RecordWithSchema {
 	var records []kallax.RecordWithSchema
 
@@ -137,13 +175,19 @@ func (s *PersonStore) relationshipRecords(record *Person) []kallax.RecordWithSch
 // Insert inserts a Person in the database. A non-persisted object is
 // required for this operation.
 func (s *PersonStore) Insert(record *Person) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Person in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *PersonStore) InsertContext(ctx context.Context, record *Person) error {
 
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
-			if err := s.Insert(Schema.Person.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.Person.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -153,7 +197,7 @@ func (s *PersonStore) Insert(record *Person) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -166,10 +210,84 @@ func (s *PersonStore) Insert(record *Person) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.Person.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.Person.BaseSchema, record)
 
 }
 
+// InsertMany inserts the given Person records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *PersonStore) InsertMany(records []*Person) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given Person records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *PersonStore) InsertManyContext(ctx context.Context, records []*Person) error {
+
+	var relRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.Person.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, r := range relRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the Person records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *PersonStore) CopyFrom(records <-chan *Person) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Person records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *PersonStore) CopyFromContext(ctx context.Context, records <-chan *Person) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Person.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Person
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -177,13 +295,19 @@ func (s *PersonStore) Insert(record *Person) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *PersonStore) UpdateContext(ctx context.Context, record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
-			updated, err = s.Update(Schema.Person.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -194,7 +318,7 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -212,18 +336,25 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.Person.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *PersonStore) Save(record *Person) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *PersonStore) SaveContext(ctx context.Context, record *Person) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -231,16 +362,73 @@ func (s *PersonStore) Save(record *Person) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *PersonStore) Upsert(record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *PersonStore) UpsertContext(ctx context.Context, record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.Person.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *PersonStore) Delete(record *Person) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *PersonStore) DeleteContext(ctx context.Context, record *Person) error {
 
-	return s.Store.Delete(Schema.Person.BaseSchema, record)
+	return s.Store.DeleteContext(ctx, Schema.Person.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *PersonStore) UpdateAll(q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *PersonStore) UpdateAllContext(ctx context.Context, q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *PersonStore) DeleteAll(q *PersonQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *PersonStore) DeleteAllContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *PersonStore) Find(q *PersonQuery) (*PersonResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *PersonStore) FindContext(ctx context.Context, q *PersonQuery) (*PersonResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -254,24 +442,74 @@ func (s *PersonStore) MustFind(q *PersonQuery) *PersonResultSet {
 	return NewPersonResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Person records.
+func (s *PersonStore) FindInto(q *PersonQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *PersonStore) FindIntoContext(ctx context.Context, q *PersonQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *PersonStore) Count(q *PersonQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *PersonStore) CountContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *PersonStore) MustCount(q *PersonQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *PersonStore) Aggregate(q *PersonQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *PersonStore) AggregateContext(ctx context.Context, q *PersonQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *PersonStore) AggregateInto(q *PersonQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *PersonStore) AggregateIntoContext(ctx context.Context, q *PersonQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *PersonStore) FindOneContext(ctx context.Context, q *PersonQuery) (*Person, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +532,13 @@ func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *PersonStore) FindAll(q *PersonQuery) ([]*Person, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *PersonStore) FindAllContext(ctx context.Context, q *PersonQuery) ([]*Person, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -318,16 +562,52 @@ func (s *PersonStore) Reload(record *Person) error {
 	return s.Store.Reload(Schema.Person.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Person with the data in the database using
+// the given context and makes it writable.
+func (s *PersonStore) ReloadContext(ctx context.Context, record *Person) error {
+	return s.Store.ReloadContext(ctx, Schema.Person.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionContext(ctx context.Context, callback func(*PersonStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&PersonStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionWith(opts *sql.TxOptions, callback func(*PersonStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*PersonStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&PersonStore{store})
 	})
 }
@@ -336,6 +616,14 @@ func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
 // model. If no items are given, it removes all of them.
 // The items will also be removed from the passed record inside this method.
 func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
+	return s.RemovePetsContext(context.Background(), record, deleted...)
+}
+
+// RemovePetsContext removes the given items of the Pets field of the
+// model using the given context. If no items are given, it removes all of
+// them.
+// The items will also be removed from the passed record inside this method.
+func (s *PersonStore) RemovePetsContext(ctx context.Context, record *Person, deleted ...*Pet) error {
 	var updated []*Pet
 	var clear bool
 	if len(deleted) == 0 {
@@ -347,7 +635,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 	}
 
 	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
+		err := s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 			for _, d := range deleted {
 				var r kallax.Record = d
 
@@ -357,7 +645,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 					}
 				}
 
-				if err := s.Delete(Schema.Pet.BaseSchema, d); err != nil {
+				if err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, d); err != nil {
 					return err
 				}
 
@@ -388,8 +676,8 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 
 		var err error
 		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.Pet.BaseSchema, r)
+			err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, r)
 				if err != nil {
 					return err
 				}
@@ -397,7 +685,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 				return afterDeleter.AfterDelete()
 			})
 		} else {
-			err = s.Store.Delete(Schema.Pet.BaseSchema, deleted[0])
+			err = s.Store.DeleteContext(ctx, Schema.Pet.BaseSchema, deleted[0])
 		}
 
 		if err != nil {
@@ -470,6 +758,13 @@ func (q *PersonQuery) BatchSize(size uint64) *PersonQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *PersonQuery) UseCursor() *PersonQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *PersonQuery) Limit(n uint64) *PersonQuery {
 	q.BaseQuery.Limit(n)
@@ -482,6 +777,20 @@ func (q *PersonQuery) Offset(n uint64) *PersonQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PersonQuery) After(cursor kallax.PageCursor) *PersonQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PersonQuery) Before(cursor kallax.PageCursor) *PersonQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
@@ -489,11 +798,70 @@ func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *PersonQuery) GroupBy(fields ...kallax.SchemaField) *PersonQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *PersonQuery) Having(cond kallax.Condition) *PersonQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *PersonQuery) ForUpdate() *PersonQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *PersonQuery) ForShare() *PersonQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *PersonQuery) SkipLocked() *PersonQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *PersonQuery) NoWait() *PersonQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *PersonQuery) Of(schemas ...kallax.Schema) *PersonQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *PersonQuery) WithPets(cond kallax.Condition) *PersonQuery {
 	q.AddRelation(Schema.Pet.BaseSchema, "Pets", kallax.OneToMany, cond)
 	return q
 }
 
+// WherePetsExist adds a condition to the query that requires that any of
+// the Pets of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *PersonQuery) WherePetsExist(sub *PetQuery) *PersonQuery {
+	if sub == nil {
+		sub = NewPetQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Pets", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -617,6 +985,18 @@ func (rs *PersonResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Person retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *PersonResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *PersonResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -685,6 +1065,12 @@ func NewPetStore(db *sql.DB) *PetStore {
 	return &PetStore{kallax.NewStore(db)}
 }
 
+// NewPetStoreWithDialect creates a new instance of PetStore
+// using a SQL database of the given dialect.
+func NewPetStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *PetStore {
+	return &PetStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *PetStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -707,14 +1093,98 @@ func (s *PetStore) DebugWith(logger kallax.LoggerFunc) *PetStore {
 	return &PetStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *PetStore) WithInterceptors(interceptors ...kallax.Interceptor) *PetStore {
+	return &PetStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *PetStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *PetStore {
+	return &PetStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *PetStore) WithTracer(tracer kallax.Tracer) *PetStore {
+	return &PetStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *PetStore) WithMetrics(sink kallax.MetricsSink) *PetStore {
+	return &PetStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *PetStore) WithRetry(policy kallax.RetryPolicy) *PetStore {
+	return &PetStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a Pet in the database. A non-persisted object is
 // required for this operation.
 func (s *PetStore) Insert(record *Pet) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Pet in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *PetStore) InsertContext(ctx context.Context, record *Pet) error {
+
+	return s.Store.InsertContext(ctx, Schema.Pet.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given Pet records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *PetStore) InsertMany(records []*Pet) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given Pet records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *PetStore) InsertManyContext(ctx context.Context, records []*Pet) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
 
-	return s.Store.Insert(Schema.Pet.BaseSchema, record)
+	return s.Store.InsertManyContext(ctx, Schema.Pet.BaseSchema, kallaxRecords...)
 
 }
 
+// CopyFrom inserts the Pet records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *PetStore) CopyFrom(records <-chan *Pet) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Pet records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *PetStore) CopyFromContext(ctx context.Context, records <-chan *Pet) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Pet.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Pet
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -722,19 +1192,32 @@ func (s *PetStore) Insert(record *Pet) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-	return s.Store.Update(Schema.Pet.BaseSchema, record, cols...)
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *PetStore) UpdateContext(ctx context.Context, record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.Pet.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *PetStore) Save(record *Pet) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *PetStore) SaveContext(ctx context.Context, record *Pet) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -742,16 +1225,73 @@ func (s *PetStore) Save(record *Pet) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *PetStore) Upsert(record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *PetStore) UpsertContext(ctx context.Context, record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.Pet.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *PetStore) Delete(record *Pet) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *PetStore) DeleteContext(ctx context.Context, record *Pet) error {
 
-	return s.Store.Delete(Schema.Pet.BaseSchema, record)
+	return s.Store.DeleteContext(ctx, Schema.Pet.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *PetStore) UpdateAll(q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *PetStore) UpdateAllContext(ctx context.Context, q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
 
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *PetStore) DeleteAll(q *PetQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *PetStore) DeleteAllContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *PetStore) Find(q *PetQuery) (*PetResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *PetStore) FindContext(ctx context.Context, q *PetQuery) (*PetResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -765,24 +1305,74 @@ func (s *PetStore) MustFind(q *PetQuery) *PetResultSet {
 	return NewPetResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Pet records.
+func (s *PetStore) FindInto(q *PetQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *PetStore) FindIntoContext(ctx context.Context, q *PetQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *PetStore) Count(q *PetQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *PetStore) CountContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *PetStore) MustCount(q *PetQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *PetStore) Aggregate(q *PetQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *PetStore) AggregateContext(ctx context.Context, q *PetQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *PetStore) AggregateInto(q *PetQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *PetStore) AggregateIntoContext(ctx context.Context, q *PetQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *PetStore) FindOneContext(ctx context.Context, q *PetQuery) (*Pet, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -805,7 +1395,13 @@ func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *PetStore) FindAll(q *PetQuery) ([]*Pet, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *PetStore) FindAllContext(ctx context.Context, q *PetQuery) ([]*Pet, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -829,16 +1425,52 @@ func (s *PetStore) Reload(record *Pet) error {
 	return s.Store.Reload(Schema.Pet.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Pet with the data in the database using
+// the given context and makes it writable.
+func (s *PetStore) ReloadContext(ctx context.Context, record *Pet) error {
+	return s.Store.ReloadContext(ctx, Schema.Pet.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *PetStore) Transaction(callback func(*PetStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionContext(ctx context.Context, callback func(*PetStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&PetStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionWith(opts *sql.TxOptions, callback func(*PetStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*PetStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&PetStore{store})
 	})
 }
@@ -892,6 +1524,13 @@ func (q *PetQuery) BatchSize(size uint64) *PetQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *PetQuery) UseCursor() *PetQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *PetQuery) Limit(n uint64) *PetQuery {
 	q.BaseQuery.Limit(n)
@@ -904,6 +1543,20 @@ func (q *PetQuery) Offset(n uint64) *PetQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PetQuery) After(cursor kallax.PageCursor) *PetQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PetQuery) Before(cursor kallax.PageCursor) *PetQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
@@ -911,6 +1564,55 @@ func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *PetQuery) GroupBy(fields ...kallax.SchemaField) *PetQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *PetQuery) Having(cond kallax.Condition) *PetQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *PetQuery) ForUpdate() *PetQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *PetQuery) ForShare() *PetQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *PetQuery) SkipLocked() *PetQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *PetQuery) NoWait() *PetQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *PetQuery) Of(schemas ...kallax.Schema) *PetQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1040,6 +1742,18 @@ func (rs *PetResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Pet retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *PetResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *PetResultSet) Close() error {
 	return rs.ResultSet.Close()
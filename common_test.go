@@ -17,13 +17,28 @@ func envOrDefault(key string, def string) string {
 	return v
 }
 
-func openTestDB() (*sql.DB, error) {
-	return sql.Open("postgres", fmt.Sprintf(
-		"postgres://%s:%s@0.0.0.0:5432/%s?sslmode=disable",
+func testDBURL() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s/%s?sslmode=disable",
 		envOrDefault("DBUSER", "testing"),
 		envOrDefault("DBPASS", "testing"),
+		envOrDefault("DBHOST", "0.0.0.0:5432"),
 		envOrDefault("DBNAME", "testing"),
-	))
+	)
+}
+
+func openTestDB() (*sql.DB, error) {
+	return sql.Open("postgres", testDBURL())
+}
+
+// newTestStore returns a new store for the given database with the dialect
+// of the DBDIALECT environment variable, so the tests can also be run
+// against CockroachDB.
+func newTestStore(db *sql.DB) *Store {
+	if os.Getenv("DBDIALECT") == CockroachDB.Name() {
+		return NewStoreWithDialect(db, CockroachDB)
+	}
+	return NewStore(db)
 }
 
 func setupTables(t *testing.T, db *sql.DB) {
@@ -0,0 +1,429 @@
+package kallax
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrCopyNotSupported is returned when the rows of a table are copied
+	// with a store whose dialect or driver does not support COPY.
+	ErrCopyNotSupported = errors.New("kallax: COPY is not supported by the store")
+	// ErrCopyRelationships is returned when a query with relationships is
+	// copied with CopyTo.
+	ErrCopyRelationships = errors.New("kallax: queries with relationships can not be copied")
+)
+
+// CopyFormat is the format of the rows written by CopyTo.
+type CopyFormat string
+
+const (
+	// CopyCSV writes the rows as comma separated values, without header.
+	CopyCSV CopyFormat = "csv"
+	// CopyText writes the rows in the text format of PostgreSQL, with tab
+	// separated values.
+	CopyText CopyFormat = "text"
+	// CopyBinary writes the rows in the binary format of PostgreSQL.
+	CopyBinary CopyFormat = "binary"
+)
+
+// RecordIterator is an iterator over the records to copy to a table.
+type RecordIterator interface {
+	// Next returns the next record, or io.EOF if there are no more records.
+	Next() (Record, error)
+}
+
+// RecordIteratorFunc is a function that implements the RecordIterator
+// interface.
+type RecordIteratorFunc func() (Record, error)
+
+// Next returns the result of calling the function.
+func (f RecordIteratorFunc) Next() (Record, error) {
+	return f()
+}
+
+// NewRecordIterator returns an iterator over the given records.
+func NewRecordIterator(records ...Record) RecordIterator {
+	return RecordIteratorFunc(func() (Record, error) {
+		if len(records) == 0 {
+			return nil, io.EOF
+		}
+
+		record := records[0]
+		records = records[1:]
+		return record, nil
+	})
+}
+
+// noRetryPolicy is the policy of the transactions that can not be retried.
+var noRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+// CopyFrom inserts all the records returned by the given iterator in the
+// table using COPY FROM, which is much faster than inserting them, as the
+// rows are streamed to the database. Only non-persisted records can be
+// copied, and they are not marked as persisted after it, since the ids of
+// the records with auto incrementable primary keys are not retrieved.
+// The copy is done in a transaction, which is rolled back if the iterator
+// returns an error, and it is never retried, since the iterator can not be
+// consumed again. Returns the number of rows copied.
+func (s *Store) CopyFrom(schema Schema, records RecordIterator) (int64, error) {
+	return s.CopyFromContext(context.Background(), schema, records)
+}
+
+// CopyFromContext inserts all the records returned by the given iterator in
+// the table using COPY FROM with the given context. See CopyFrom for more
+// details.
+func (s *Store) CopyFromContext(ctx context.Context, schema Schema, records RecordIterator) (copied int64, err error) {
+	ctx, span := s.startOperation(ctx, CopyOperation, schema.Table())
+	defer func() { span.Finish(err) }()
+
+	if !s.dialect.supportsCopy() {
+		return 0, ErrCopyNotSupported
+	}
+
+	if _, ok := s.runner.(rowsCopier); ok {
+		return s.copyFrom(ctx, schema, records)
+	}
+
+	store := s.copy()
+	store.retry = &noRetryPolicy
+	err = store.TransactionContext(ctx, func(store *Store) error {
+		copied, err = store.copyFrom(ctx, schema, records)
+		return err
+	})
+	return copied, err
+}
+
+// copyFrom copies the given records to the table of the schema, in the
+// transaction of the store if it does not copy them in one itself.
+func (s *Store) copyFrom(ctx context.Context, schema Schema, records RecordIterator) (int64, error) {
+	cols := ColumnNames(schema.Columns())
+	if schema.isPrimaryKeyAutoIncrementable() {
+		cols = cols[1:]
+	}
+
+	query := fmt.Sprintf(
+		"COPY %s (%s) FROM STDIN",
+		s.dialect.QuoteIdentifier(schema.Table()),
+		strings.Join(quoteColumns(s.dialect, cols), ", "),
+	)
+
+	return copyFrom(ctx, s.proxy, query, func() ([]interface{}, error) {
+		record, err := records.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		if record.IsPersisted() {
+			return nil, ErrNonNewDocument
+		}
+
+		values, err := s.recordValues(record, cols...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, v := range values {
+			if values[i], err = copyValue(v); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	})
+}
+
+// CopyTo writes the rows selected by the given query to the given writer in
+// the given format using COPY TO, so they are streamed from the database
+// without being scanned into records. The columns are written in the order
+// of the selected columns of the query, and its conditions, order, limit and
+// offset are applied, but queries with relationships can not be copied.
+// Returns the number of rows copied.
+// Only the stores created with NewStoreWithNativeDriver, such as the ones of
+// the pgxstore package, can copy rows to a writer, and not inside
+// transactions. The stores created with NewStore and NewStoreWithDialect,
+// which use lib/pq, always return ErrCopyNotSupported, since lib/pq does not
+// support COPY TO.
+func (s *Store) CopyTo(q Query, w io.Writer, format CopyFormat) (int64, error) {
+	return s.CopyToContext(context.Background(), q, w, format)
+}
+
+// CopyToContext writes the rows selected by the given query to the given
+// writer in the given format using COPY TO with the given context. See
+// CopyTo for more details.
+func (s *Store) CopyToContext(ctx context.Context, q Query, w io.Writer, format CopyFormat) (copied int64, err error) {
+	ctx, span := s.startOperation(ctx, CopyOperation, q.Schema().Table())
+	defer func() { span.Finish(err) }()
+
+	if !s.dialect.supportsCopy() {
+		return 0, ErrCopyNotSupported
+	}
+
+	switch format {
+	case CopyCSV, CopyText, CopyBinary:
+	default:
+		return 0, fmt.Errorf("kallax: invalid copy format: %s", format)
+	}
+
+	if len(q.getRelationships()) > 0 {
+		return 0, ErrCopyRelationships
+	}
+
+	_, builder := q.compile(s.dialect)
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// COPY does not accept parameters, so the arguments are inlined
+	if query, err = inlineArgs(query, args); err != nil {
+		return 0, err
+	}
+
+	query = fmt.Sprintf("COPY (%s) TO STDOUT WITH (FORMAT %s)", query, format)
+	return copyTo(ctx, s.proxy, query, &contextWriter{ctx, w})
+}
+
+// copySource returns the values of the next row to copy, already converted
+// to the text format of COPY, or io.EOF if there are no more rows.
+type copySource func() ([]interface{}, error)
+
+// rowsCopier is implemented by the database proxies that can run COPY
+// statements.
+type rowsCopier interface {
+	copyFrom(ctx context.Context, query string, src copySource) (int64, error)
+	copyTo(ctx context.Context, query string, w io.Writer) (int64, error)
+}
+
+// copyFrom runs the given COPY FROM statement in the proxy with the rows of
+// the given source and returns the number of rows copied.
+func copyFrom(ctx context.Context, proxy dbProxy, query string, src copySource) (int64, error) {
+	if c, ok := proxy.(rowsCopier); ok {
+		return c.copyFrom(ctx, query, src)
+	}
+	return 0, ErrCopyNotSupported
+}
+
+// copyTo runs the given COPY TO statement in the proxy, writing the rows to
+// the given writer, and returns the number of rows copied.
+func copyTo(ctx context.Context, proxy dbProxy, query string, w io.Writer) (int64, error) {
+	if c, ok := proxy.(rowsCopier); ok {
+		return c.copyTo(ctx, query, w)
+	}
+	return 0, ErrCopyNotSupported
+}
+
+// contextWriter is a writer that fails once its context is done, so the
+// COPY TO statements writing to it are aborted.
+type contextWriter struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+func (w *contextWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.w.Write(p)
+}
+
+// txProxy is a database proxy of a database/sql transaction, which runs the
+// COPY FROM statements with the copy support of lib/pq.
+type txProxy struct {
+	dbProxy
+	tx *sql.Tx
+}
+
+func (p *txProxy) copyFrom(ctx context.Context, query string, src copySource) (int64, error) {
+	stmt, err := p.tx.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := copyRows(ctx, stmt, src)
+	if closeErr := stmt.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// copyTo always fails, since lib/pq does not support COPY TO.
+func (p *txProxy) copyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	return 0, ErrCopyNotSupported
+}
+
+func copyRows(ctx context.Context, stmt *sql.Stmt, src copySource) (int64, error) {
+	var n int64
+	for {
+		values, err := src()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	// the rows are flushed with an empty exec
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// copyValue returns the given column value converted to the text format of
+// COPY, which is either a string or nil for NULL. Values implementing
+// driver.Valuer, such as JSON, slices and arrays, are converted with it.
+func copyValue(v interface{}) (interface{}, error) {
+	var err error
+	valuer, isValuer := v.(driver.Valuer)
+	if isValuer {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, nil
+		}
+
+		v, err = valuer.Value()
+	} else {
+		v, err = driver.DefaultParameterConverter.ConvertValue(v)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return v, nil
+	case []byte:
+		// byte slices returned by valuers are JSON or arrays, not bytea
+		if isValuer {
+			return string(v), nil
+		}
+		return `\x` + hex.EncodeToString(v), nil
+	case time.Time:
+		return string(pq.FormatTimestamp(v)), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	}
+
+	return fmt.Sprint(v), nil
+}
+
+var copyTextReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// appendCopyRow appends the given row, whose values were converted with
+// copyValue, to the given buffer in the text format of COPY.
+func appendCopyRow(buf []byte, values []interface{}) []byte {
+	for i, v := range values {
+		if i > 0 {
+			buf = append(buf, '\t')
+		}
+
+		if v == nil {
+			buf = append(buf, `\N`...)
+			continue
+		}
+
+		buf = append(buf, copyTextReplacer.Replace(v.(string))...)
+	}
+	return append(buf, '\n')
+}
+
+// inlineArgs returns the given query with its placeholders replaced by the
+// literals of the given arguments, which are converted with copyValue.
+// Placeholders inside quoted strings and identifiers are not replaced.
+func inlineArgs(query string, args []interface{}) (string, error) {
+	if len(args) == 0 {
+		return query, nil
+	}
+
+	var buf bytes.Buffer
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '$':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+
+			if j == i+1 {
+				break
+			}
+
+			n, err := strconv.Atoi(query[i+1 : j])
+			if err != nil || n < 1 || n > len(args) {
+				return "", fmt.Errorf("kallax: invalid placeholder %s", query[i:j])
+			}
+
+			v, err := copyValue(args[n-1])
+			if err != nil {
+				return "", err
+			}
+
+			if v == nil {
+				buf.WriteString("NULL")
+			} else {
+				buf.WriteString(quoteLiteral(v.(string)))
+			}
+			i = j - 1
+			continue
+		}
+
+		buf.WriteByte(c)
+	}
+
+	return buf.String(), nil
+}
+
+// quoteLiteral returns the given string quoted as a PostgreSQL literal.
+func quoteLiteral(s string) string {
+	s = strings.Replace(s, "'", "''", -1)
+	if strings.Contains(s, `\`) {
+		return `E'` + strings.Replace(s, `\`, `\\`, -1) + "'"
+	}
+	return "'" + s + "'"
+}
@@ -0,0 +1,239 @@
+package kallax
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+func TestCopyValue(t *testing.T) {
+	type status string
+	var (
+		nilStr  *string
+		str     = "foo"
+		id      = NewULID()
+		created = time.Date(2017, 3, 4, 5, 6, 7, 8000, time.UTC)
+	)
+
+	cases := []struct {
+		value    interface{}
+		expected interface{}
+	}{
+		{nil, nil},
+		{nilStr, nil},
+		{&str, "foo"},
+		{status("bar"), "bar"},
+		{42, "42"},
+		{uint8(7), "7"},
+		{1.5, "1.5"},
+		{true, "true"},
+		{[]byte("foo"), `\x666f6f`},
+		{created, "2017-03-04 05:06:07.000008Z"},
+		{id, id.String()},
+		{types.JSON(map[string]int{"a": 1}), `{"a":1}`},
+		{types.Slice([]string{"a", "b c"}), `{"a","b c"}`},
+		{types.Slice([]int64{1, 2}), `{1,2}`},
+	}
+
+	for _, c := range cases {
+		v, err := copyValue(c.value)
+		require.NoError(t, err, "%#v", c.value)
+		require.Equal(t, c.expected, v, "%#v", c.value)
+	}
+}
+
+func TestAppendCopyRow(t *testing.T) {
+	row := appendCopyRow(nil, []interface{}{"foo", nil, "a\tb\nc\\d\r"})
+	require.Equal(t, "foo\t\\N\ta\\tb\\nc\\\\d\\r\n", string(row))
+}
+
+func TestNewRecordIterator(t *testing.T) {
+	require := require.New(t)
+	a, b := newModel("a", "", 1), newModel("b", "", 2)
+	it := NewRecordIterator(a, b)
+
+	for _, expected := range []Record{a, b} {
+		record, err := it.Next()
+		require.NoError(err)
+		require.Equal(expected, record)
+	}
+
+	_, err := it.Next()
+	require.Equal(io.EOF, err)
+}
+
+func TestCopyFrom_NotSupported(t *testing.T) {
+	store := (&Store{runner: &fakeProxy{}, dialect: SQLite}).build()
+	_, err := store.CopyFrom(ModelSchema, NewRecordIterator())
+	require.Equal(t, ErrCopyNotSupported, err)
+}
+
+func (p *fakeRowsProxy) copyFrom(ctx context.Context, query string, src copySource) (int64, error) {
+	p.queries = append(p.queries, query)
+	var n int64
+	for {
+		values, err := src()
+		if err == io.EOF {
+			return n, p.err
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		p.copied = append(p.copied, values)
+		n++
+	}
+}
+
+func TestStoreCopyFrom(t *testing.T) {
+	require := require.New(t)
+
+	var stmt *Statement
+	var res *StatementResult
+	fake := &fakeRowsProxy{}
+	store := (&Store{runner: fake}).build().WithInterceptors(
+		func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+			stmt = s
+			res = next(ctx, s)
+			return res
+		},
+	)
+
+	n, err := store.CopyFrom(ModelSchema, NewRecordIterator(
+		newModel("a", "a@a.a", 1),
+		newModel("b\tc", "", 2),
+	))
+	require.NoError(err)
+	require.Equal(int64(2), n)
+	require.Equal([]string{`COPY "model" ("name", "email", "age") FROM STDIN`}, fake.queries)
+	require.Equal([][]interface{}{
+		{"a", "a@a.a", "1"},
+		{"b\tc", "", "2"},
+	}, fake.copied)
+
+	require.Equal(ExecStatement, stmt.Kind)
+	require.Equal(CopyOperation, stmt.Operation)
+	require.Equal("model", stmt.Table)
+	affected, err := res.Result.RowsAffected()
+	require.NoError(err)
+	require.Equal(int64(2), affected)
+
+	persisted := newModel("c", "", 3)
+	persisted.setPersisted()
+	_, err = store.CopyFrom(ModelSchema, NewRecordIterator(persisted))
+	require.Equal(ErrNonNewDocument, err)
+
+	fake.err = errors.New("foo")
+	_, err = store.CopyFrom(ModelSchema, NewRecordIterator())
+	require.EqualError(err, "foo")
+}
+
+func (p *fakeRowsProxy) copyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	p.queries = append(p.queries, query)
+	if p.err != nil {
+		return 0, p.err
+	}
+
+	n, err := io.WriteString(w, "a,1\nb,2\n")
+	return int64(n) / 4, err
+}
+
+func TestInlineArgs(t *testing.T) {
+	cases := []struct {
+		query    string
+		args     []interface{}
+		expected string
+	}{
+		{"SELECT 1", nil, "SELECT 1"},
+		{"a = $1 AND b = $2", []interface{}{1, "foo"}, "a = '1' AND b = 'foo'"},
+		{"a = $2 OR a = $1", []interface{}{"x", nil}, "a = NULL OR a = 'x'"},
+		{"a = $1", []interface{}{"it's"}, "a = 'it''s'"},
+		{"a = $1", []interface{}{`a\b`}, `a = E'a\\b'`},
+		{`a = '$1' AND "$1" = $1`, []interface{}{1}, `a = '$1' AND "$1" = '1'`},
+		{"a = $10", []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, "a = '10'"},
+		{"a = $", []interface{}{1}, "a = $"},
+	}
+
+	for _, c := range cases {
+		query, err := inlineArgs(c.query, c.args)
+		require.NoError(t, err, c.query)
+		require.Equal(t, c.expected, query, c.query)
+	}
+
+	_, err := inlineArgs("a = $2", []interface{}{1})
+	require.Error(t, err)
+}
+
+func TestCopyTo_NotSupported(t *testing.T) {
+	store := (&Store{runner: &fakeProxy{}, dialect: SQLite}).build()
+	_, err := store.CopyTo(NewBaseQuery(ModelSchema), ioutil.Discard, CopyCSV)
+	require.Equal(t, ErrCopyNotSupported, err)
+
+	store = (&Store{runner: &fakeProxy{}}).build()
+	_, err = store.CopyTo(NewBaseQuery(ModelSchema), ioutil.Discard, CopyCSV)
+	require.Equal(t, ErrCopyNotSupported, err)
+
+	// lib/pq does not support COPY TO, in or outside transactions
+	db, err := openTestDB()
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = NewStore(db).CopyTo(NewBaseQuery(ModelSchema), ioutil.Discard, CopyCSV)
+	require.Equal(t, ErrCopyNotSupported, err)
+
+	proxy := &txProxy{dbProxy: &fakeProxy{}}
+	_, err = proxy.copyTo(context.Background(), "COPY model TO STDOUT", ioutil.Discard)
+	require.Equal(t, ErrCopyNotSupported, err)
+}
+
+func TestStoreCopyTo(t *testing.T) {
+	require := require.New(t)
+
+	var stmt *Statement
+	fake := &fakeRowsProxy{}
+	store := (&Store{runner: fake}).build().WithInterceptors(
+		func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+			stmt = s
+			return next(ctx, s)
+		},
+	)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"), f("age"))
+	q.Where(Eq(f("name"), "it's"))
+	q.Order(Asc(f("age")))
+	q.Limit(10)
+
+	var buf bytes.Buffer
+	n, err := store.CopyTo(q, &buf, CopyCSV)
+	require.NoError(err)
+	require.Equal(int64(2), n)
+	require.Equal("a,1\nb,2\n", buf.String())
+	require.Equal([]string{
+		`COPY (SELECT __model.name, __model.age FROM model __model WHERE __model.name = 'it''s' ORDER BY __model.age ASC LIMIT 10) TO STDOUT WITH (FORMAT csv)`,
+	}, fake.queries)
+
+	require.Equal(ExecStatement, stmt.Kind)
+	require.Equal(CopyOperation, stmt.Operation)
+	require.Equal("model", stmt.Table)
+
+	_, err = store.CopyTo(q, &buf, CopyFormat("xml"))
+	require.EqualError(err, "kallax: invalid copy format: xml")
+
+	q = NewBaseQuery(ModelSchema)
+	require.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	_, err = store.CopyTo(q, &buf, CopyCSV)
+	require.Equal(ErrCopyRelationships, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = store.CopyToContext(ctx, NewBaseQuery(ModelSchema), &buf, CopyText)
+	require.Equal(context.Canceled, err)
+}
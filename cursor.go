@@ -0,0 +1,124 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ErrCursorNotSupported is returned when a query using a cursor is run with
+// a store whose dialect does not support server-side cursors.
+var ErrCursorNotSupported = errors.New("kallax: cursors are not supported by the store")
+
+// cursorSeq is the sequence of the names of the cursors, which have to be
+// unique in a transaction.
+var cursorSeq uint64
+
+// cursor is a server-side cursor the rows of a query are fetched from.
+type cursor struct {
+	name string
+	db   dbProxy
+	// tx is the transaction opened for the cursor if the store was not in
+	// one already, which is committed once the cursor is closed.
+	tx      *sql.Tx
+	metrics MetricsSink
+	closed  bool
+	err     error
+}
+
+// findCursor declares a cursor for the given query and returns a result set
+// fetching its rows in batches. The cursor is declared in the transaction of
+// the store, or in a new one that is ended when the cursor is closed.
+func (s *Store) findCursor(ctx context.Context, q Query) (ResultSet, error) {
+	if !s.dialect.supportsCursors() {
+		return nil, ErrCursorNotSupported
+	}
+
+	ctx = withOperation(ctx, FindOperation, q.Schema().Table())
+	c := &cursor{
+		name:    fmt.Sprintf("kallax_cursor_%d", atomic.AddUint64(&cursorSeq, 1)),
+		db:      s.proxy,
+		metrics: s.metrics,
+	}
+
+	if s.db != nil {
+		tx, err := s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, &txError{"kallax: can't open transaction", err}
+		}
+
+		c.tx = tx
+		c.db = s.newStoreWithTransaction(tx).proxy
+	}
+
+	runner := newBatchQueryRunner(ctx, q.Schema(), c.db, q, s.dialect)
+	runner.tracer = s.tracer
+	runner.metrics = s.metrics
+	runner.cursor = c
+
+	builder := runner.builder
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	if err := c.declare(ctx, builder); err != nil {
+		c.close(ctx, true)
+		return nil, err
+	}
+
+	return NewBatchingResultSet(runner), nil
+}
+
+// declare declares the cursor for the query of the given builder.
+func (c *cursor) declare(ctx context.Context, builder squirrel.SelectBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", c.name, query)
+	_, err = c.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// fetch returns the next n rows of the cursor.
+func (c *cursor) fetch(ctx context.Context, n uint64) (resultRows, error) {
+	query := fmt.Sprintf("FETCH FORWARD %d FROM %s", n, c.name)
+	return queryRows(ctx, c.db, query, nil)
+}
+
+// close closes the cursor and ends its transaction, if it has one, which is
+// rolled back if failed is true or the cursor can not be closed. Closing it
+// again returns the same error as the first time.
+func (c *cursor) close(ctx context.Context, failed bool) error {
+	if c.closed {
+		return c.err
+	}
+	c.closed = true
+
+	_, c.err = c.db.ExecContext(ctx, "CLOSE "+c.name)
+	if c.tx == nil {
+		return c.err
+	}
+
+	if c.err != nil || failed {
+		c.tx.Rollback()
+		reportTransaction(c.metrics, "rollback")
+		return c.err
+	}
+
+	if err := c.tx.Commit(); err != nil {
+		c.err = &txError{"kallax: unable to commit transaction", err}
+		return c.err
+	}
+	reportTransaction(c.metrics, "commit")
+	return nil
+}
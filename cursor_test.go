@@ -0,0 +1,103 @@
+package kallax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCursor(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	store := (&Store{runner: fake}).build()
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 1))
+	q.BatchSize(10)
+	q.Limit(20)
+	q.UseCursor()
+	rs, err := store.Find(q)
+	require.NoError(err)
+
+	name := rs.(*BatchingResultSet).runner.cursor.name
+	require.False(rs.Next())
+	require.NoError(rs.Close())
+	require.Equal([]string{
+		fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR SELECT __model.id, __model.name, __model.email, __model.age FROM model __model WHERE __model.age > $1 LIMIT 20", name),
+		fmt.Sprintf("FETCH FORWARD 10 FROM %s", name),
+		fmt.Sprintf("CLOSE %s", name),
+	}, fake.queries)
+}
+
+func TestFindCursor_Error(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	fake.err = errors.New("foo")
+	store := (&Store{runner: fake}).build()
+
+	q := NewBaseQuery(ModelSchema)
+	q.UseCursor()
+	_, err := store.Find(q)
+	require.EqualError(err, "foo")
+	require.Len(fake.queries, 2)
+	require.Contains(fake.queries[1], "CLOSE kallax_cursor_")
+}
+
+func TestFindCursor_FetchError(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	store := (&Store{runner: fake}).build()
+
+	q := NewBaseQuery(ModelSchema)
+	q.UseCursor()
+	rs, err := store.Find(q)
+	require.NoError(err)
+
+	name := rs.(*BatchingResultSet).runner.cursor.name
+	fake.err = errors.New("foo")
+	require.True(rs.Next())
+	_, err = rs.Get(nil)
+	require.EqualError(err, "foo")
+	require.False(rs.Next())
+	require.Equal(fmt.Sprintf("CLOSE %s", name), fake.queries[len(fake.queries)-1])
+	require.Len(fake.queries, 3)
+}
+
+func TestFindCursor_ContextCancelled(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	store := (&Store{runner: fake}).build()
+
+	q := NewBaseQuery(ModelSchema)
+	q.UseCursor()
+	ctx, cancel := context.WithCancel(context.Background())
+	rs, err := store.FindContext(ctx, q)
+	require.NoError(err)
+
+	name := rs.(*BatchingResultSet).runner.cursor.name
+	cancel()
+	require.True(rs.Next())
+	_, err = rs.Get(nil)
+	require.Equal(context.Canceled, err)
+	require.False(rs.Next())
+	require.NoError(rs.Close())
+	require.Equal([]string{
+		fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR SELECT __model.id, __model.name, __model.email, __model.age FROM model __model", name),
+		fmt.Sprintf("CLOSE %s", name),
+	}, fake.queries)
+}
+
+func TestFindCursor_NotSupported(t *testing.T) {
+	store := (&Store{runner: &fakeProxy{}, dialect: MySQL}).build()
+	q := NewBaseQuery(ModelSchema)
+	q.UseCursor()
+	_, err := store.Find(q)
+	require.Equal(t, ErrCursorNotSupported, err)
+}
@@ -0,0 +1,521 @@
+package kallax
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+// ErrUnsupportedOperator is the cause of the errors returned when a query
+// uses an operator that can not be expressed in the dialect of the store.
+var ErrUnsupportedOperator = errors.New("kallax: operator not supported by the dialect")
+
+// Dialect defines the SQL flavour of the database a store runs its
+// statements on.
+type Dialect interface {
+	// Name returns the name of the dialect.
+	Name() string
+	// PlaceholderFormat returns the format of the placeholders of the
+	// statements.
+	PlaceholderFormat() squirrel.PlaceholderFormat
+	// QuoteIdentifier returns the given identifier quoted.
+	QuoteIdentifier(string) string
+	// JSONPath returns the SQL expression to retrieve the element at the
+	// given path of the given JSON column as a value of the given type.
+	JSONPath(col string, typ JSONKeyType, path ...string) string
+	// supportsReturning reports whether the values of the inserted or
+	// updated rows can be retrieved with a RETURNING clause. Otherwise, the
+	// auto incremented ids are retrieved with LastInsertId.
+	supportsReturning() bool
+	// ordersReturning reports whether the rows returned by the RETURNING
+	// clause of an insert of many rows are in the same order as their
+	// values. Otherwise, the auto incremented ids are retrieved with
+	// LastInsertId.
+	ordersReturning() bool
+	// firstInsertID returns the id of the first of the given number of rows
+	// inserted by a single statement, given its last insert id.
+	firstInsertID(lastInsertID int64, rows int) int64
+	// aliasedTable returns the target of an UPDATE or DELETE statement on
+	// the given table with the given alias.
+	aliasedTable(table, alias string) string
+	// currentTimestamp returns the SQL expression of the current time.
+	currentTimestamp() string
+	// maxParams returns the maximum number of parameters that can be sent
+	// in a single statement.
+	maxParams() int
+	// countsChangedRows reports whether the number of rows affected by an
+	// update only counts the rows whose values changed, instead of all the
+	// rows matched by it.
+	countsChangedRows() bool
+	// operator returns the format of the operator with the given name, as
+	// NewOperator expects it, and whether the dialect supports it at all.
+	operator(name string) (string, bool)
+	// upsertClause returns the clause added to an insert of a record of the
+	// given schema to turn it into an upsert.
+	upsertClause(schema Schema, conflictCols, updateCols []SchemaField) string
+	// column returns the value or the address of a column of a record
+	// converted to the way the dialect stores it.
+	column(v interface{}) interface{}
+	// restartsTransactions reports whether transactions must be retried
+	// with the client-side retry protocol of CockroachDB.
+	restartsTransactions() bool
+	// supportsCopy reports whether rows can be copied to tables with COPY
+	// FROM STDIN.
+	supportsCopy() bool
+	// supportsCursors reports whether rows can be fetched from server-side
+	// cursors declared with DECLARE ... CURSOR.
+	supportsCursors() bool
+	// supportsLocking reports whether the rows selected by a query can be
+	// locked with FOR UPDATE and FOR SHARE.
+	supportsLocking() bool
+	// nullsLast reports whether NULL values are sorted after the rest of
+	// values in ascending order, and before them in descending order.
+	nullsLast() bool
+}
+
+// Names of the operators whose SQL depends on the dialect.
+const (
+	opIlike              = "ilike"
+	opSimilarTo          = "similar_to"
+	opNotSimilarTo       = "not_similar_to"
+	opArrayEq            = "array_eq"
+	opArrayNotEq         = "array_not_eq"
+	opArrayLt            = "array_lt"
+	opArrayGt            = "array_gt"
+	opArrayLtOrEq        = "array_lt_or_eq"
+	opArrayGtOrEq        = "array_gt_or_eq"
+	opArrayContains      = "array_contains"
+	opArrayContainedBy   = "array_contained_by"
+	opArrayOverlap       = "array_overlap"
+	opJSONIsObject       = "json_is_object"
+	opJSONIsArray        = "json_is_array"
+	opJSONContains       = "json_contains"
+	opJSONContainsAny    = "json_contains_any"
+	opJSONContainedBy    = "json_contained_by"
+	opJSONContainsAnyKey = "json_contains_any_key"
+	opJSONContainsAllKey = "json_contains_all_keys"
+	opMatchRegexCase     = "match_regex_case"
+	opMatchRegex         = "match_regex"
+	opNotMatchRegexCase  = "not_match_regex_case"
+	opNotMatchRegex      = "not_match_regex"
+)
+
+var (
+	// PostgreSQL is the dialect of PostgreSQL, used by default by the stores.
+	PostgreSQL Dialect = postgreSQL{}
+	// MySQL is the dialect of MySQL. Version 8.0 or newer is required to use
+	// the JSON and regular expression operators.
+	MySQL Dialect = mySQL{}
+	// SQLite is the dialect of SQLite. Version 3.35.0 or newer is required,
+	// as it uses RETURNING, and the JSON1 extension must be available.
+	// Arrays are stored as JSON arrays.
+	SQLite Dialect = sqlite{}
+	// CockroachDB is the dialect of CockroachDB, which is the same as the
+	// PostgreSQL one, but transactions are retried inside the database with
+	// cockroach_restart savepoints.
+	CockroachDB Dialect = cockroachDB{}
+)
+
+type postgreSQL struct{}
+
+func (postgreSQL) Name() string { return "postgresql" }
+
+func (postgreSQL) PlaceholderFormat() squirrel.PlaceholderFormat { return squirrel.Dollar }
+
+func (postgreSQL) QuoteIdentifier(name string) string { return fmt.Sprintf("%q", name) }
+
+func (postgreSQL) JSONPath(col string, typ JSONKeyType, path ...string) string {
+	op := "#>"
+	format := "%s %s'{%s}'"
+	if typ == JSONText {
+		op = "#>>"
+	} else if typ != JSONAny {
+		op = "#>>"
+		format = "CAST(%s %s'{%s}' as " + string(typ) + ")"
+	}
+
+	return fmt.Sprintf(format, col, op, strings.Join(path, ","))
+}
+
+func (postgreSQL) supportsReturning() bool { return true }
+
+func (postgreSQL) ordersReturning() bool { return true }
+
+func (postgreSQL) firstInsertID(lastInsertID int64, rows int) int64 { return lastInsertID }
+
+func (postgreSQL) aliasedTable(table, alias string) string { return table + " " + alias }
+
+func (postgreSQL) countsChangedRows() bool { return false }
+
+func (postgreSQL) currentTimestamp() string { return "now()" }
+
+func (postgreSQL) maxParams() int { return 65535 }
+
+func (postgreSQL) column(v interface{}) interface{} { return v }
+
+func (postgreSQL) restartsTransactions() bool { return false }
+
+func (postgreSQL) supportsCopy() bool { return true }
+
+func (postgreSQL) supportsCursors() bool { return true }
+
+func (postgreSQL) supportsLocking() bool { return true }
+
+func (postgreSQL) nullsLast() bool { return true }
+
+var postgreSQLOperators = map[string]string{
+	opIlike:              ":col: ILIKE :arg:",
+	opSimilarTo:          ":col: SIMILAR TO :arg:",
+	opNotSimilarTo:       ":col: NOT SIMILAR TO :arg:",
+	opArrayEq:            ":col: = :arg:",
+	opArrayNotEq:         ":col: <> :arg:",
+	opArrayLt:            ":col: < :arg:",
+	opArrayGt:            ":col: > :arg:",
+	opArrayLtOrEq:        ":col: <= :arg:",
+	opArrayGtOrEq:        ":col: >= :arg:",
+	opArrayContains:      ":col: @> :arg:",
+	opArrayContainedBy:   ":col: <@ :arg:",
+	opArrayOverlap:       ":col: && :arg:",
+	opJSONIsObject:       ":col: @> '{}'",
+	opJSONIsArray:        ":col: @> '[]'",
+	opJSONContains:       ":col: @> :arg:",
+	opJSONContainedBy:    ":col: <@ :arg:",
+	opJSONContainsAnyKey: ":col: ??| :arg:",
+	opJSONContainsAllKey: ":col: ??& :arg:",
+	opMatchRegexCase:     ":col: ~ :arg:",
+	opMatchRegex:         ":col: ~* :arg:",
+	opNotMatchRegexCase:  ":col: !~ :arg:",
+	opNotMatchRegex:      ":col: !~* :arg:",
+	// the format is not used, as the operator is built by JSONContainsAny
+	opJSONContainsAny: "",
+}
+
+func (postgreSQL) operator(name string) (string, bool) {
+	format, ok := postgreSQLOperators[name]
+	return format, ok
+}
+
+func (d postgreSQL) upsertClause(schema Schema, conflictCols, updateCols []SchemaField) string {
+	var clause = "ON CONFLICT"
+	if len(conflictCols) > 0 {
+		clause += fmt.Sprintf(" (%s)", strings.Join(quoteColumns(d, ColumnNames(conflictCols)), ", "))
+	}
+
+	if len(updateCols) == 0 {
+		return clause + " DO NOTHING"
+	}
+
+	version := schema.versionColumn()
+	var sets []string
+	for _, col := range updateCols {
+		if version != nil && col.String() == version.String() {
+			continue
+		}
+		sets = append(sets, fmt.Sprintf("%q = EXCLUDED.%q", col, col))
+	}
+	if version != nil {
+		sets = append(sets, fmt.Sprintf("%q = %s.%q + 1", version, schema.Table(), version))
+	}
+	return clause + " DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+type mySQL struct{}
+
+func (mySQL) Name() string { return "mysql" }
+
+func (mySQL) PlaceholderFormat() squirrel.PlaceholderFormat { return squirrel.Question }
+
+func (mySQL) QuoteIdentifier(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+var mySQLCastTypes = map[JSONKeyType]string{
+	JSONInt:   "SIGNED",
+	JSONFloat: "DECIMAL(65,30)",
+}
+
+// jsonPath returns the given path as a SQL/JSON path expression.
+func jsonPath(path []string) string {
+	var result = "$"
+	for _, p := range path {
+		if _, err := strconv.Atoi(p); err == nil {
+			result += "[" + p + "]"
+		} else {
+			result += "." + strconv.Quote(p)
+		}
+	}
+	return result
+}
+
+func (mySQL) JSONPath(col string, typ JSONKeyType, path ...string) string {
+	expr := fmt.Sprintf("JSON_EXTRACT(%s, '%s')", col, jsonPath(path))
+	switch typ {
+	case JSONAny, JSONBool:
+		return expr
+	case JSONText:
+		return fmt.Sprintf("JSON_UNQUOTE(%s)", expr)
+	}
+
+	castType, ok := mySQLCastTypes[typ]
+	if !ok {
+		castType = string(typ)
+	}
+	return fmt.Sprintf("CAST(JSON_UNQUOTE(%s) AS %s)", expr, castType)
+}
+
+func (mySQL) supportsReturning() bool { return false }
+
+func (mySQL) ordersReturning() bool { return false }
+
+// firstInsertID returns the given id, as the last insert id of MySQL is the
+// one of the first row inserted by the statement.
+func (mySQL) firstInsertID(lastInsertID int64, rows int) int64 { return lastInsertID }
+
+func (mySQL) aliasedTable(table, alias string) string { return table + " " + alias }
+
+// countsChangedRows is true, as MySQL does not count the rows updated with
+// the same values they had unless the clientFoundRows option is set.
+func (mySQL) countsChangedRows() bool { return true }
+
+func (mySQL) currentTimestamp() string { return "CURRENT_TIMESTAMP" }
+
+func (mySQL) maxParams() int { return 65535 }
+
+func (mySQL) column(v interface{}) interface{} { return v }
+
+func (mySQL) restartsTransactions() bool { return false }
+
+func (mySQL) supportsCopy() bool { return false }
+
+func (mySQL) supportsCursors() bool { return false }
+
+func (mySQL) supportsLocking() bool { return true }
+
+func (mySQL) nullsLast() bool { return false }
+
+var mySQLOperators = map[string]string{
+	opIlike:           "LOWER(:col:) LIKE LOWER(:arg:)",
+	opJSONIsObject:    "JSON_TYPE(:col:) = 'OBJECT'",
+	opJSONIsArray:     "JSON_TYPE(:col:) = 'ARRAY'",
+	opJSONContains:    "JSON_CONTAINS(:col:, :arg:)",
+	opJSONContainedBy: "JSON_CONTAINS(:arg:, :col:)",
+	opMatchRegexCase:  "REGEXP_LIKE(:col:, :arg:, 'c')",
+	opMatchRegex:      "REGEXP_LIKE(:col:, :arg:, 'i')",
+	// parenthesized so they can be negated with NOT inside other conditions
+	opNotMatchRegexCase: "(NOT REGEXP_LIKE(:col:, :arg:, 'c'))",
+	opNotMatchRegex:     "(NOT REGEXP_LIKE(:col:, :arg:, 'i'))",
+}
+
+func (mySQL) operator(name string) (string, bool) {
+	format, ok := mySQLOperators[name]
+	return format, ok
+}
+
+// upsertClause returns an ON DUPLICATE KEY UPDATE clause. MySQL has no
+// conflict target, so any unique key of the table can trigger the update.
+// The id of an auto incremented primary key is passed to LAST_INSERT_ID, so
+// it can be retrieved even if the row is updated.
+func (d mySQL) upsertClause(schema Schema, _, updateCols []SchemaField) string {
+	id := d.QuoteIdentifier(schema.ID().String())
+	var sets []string
+	if schema.isPrimaryKeyAutoIncrementable() {
+		sets = append(sets, fmt.Sprintf("%s = LAST_INSERT_ID(%s)", id, id))
+	}
+
+	version := schema.versionColumn()
+	for _, col := range updateCols {
+		if version != nil && col.String() == version.String() {
+			continue
+		}
+		name := d.QuoteIdentifier(col.String())
+		sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", name, name))
+	}
+
+	if len(updateCols) > 0 && version != nil {
+		name := d.QuoteIdentifier(version.String())
+		sets = append(sets, fmt.Sprintf("%s = %s + 1", name, name))
+	}
+
+	if len(sets) == 0 {
+		sets = append(sets, fmt.Sprintf("%s = %s", id, id))
+	}
+
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+}
+
+type sqlite struct{}
+
+func (sqlite) Name() string { return "sqlite" }
+
+func (sqlite) PlaceholderFormat() squirrel.PlaceholderFormat { return squirrel.Question }
+
+func (sqlite) QuoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+var sqliteCastTypes = map[JSONKeyType]string{
+	JSONInt:   "INTEGER",
+	JSONFloat: "REAL",
+}
+
+// JSONPath returns a json_extract expression, which already returns the
+// strings unquoted and the booleans as integers.
+func (sqlite) JSONPath(col string, typ JSONKeyType, path ...string) string {
+	expr := fmt.Sprintf("json_extract(%s, '%s')", col, jsonPath(path))
+	if castType, ok := sqliteCastTypes[typ]; ok {
+		return fmt.Sprintf("CAST(%s AS %s)", expr, castType)
+	}
+	return expr
+}
+
+func (sqlite) supportsReturning() bool { return true }
+
+// ordersReturning is false, as SQLite does not guarantee the order of the
+// rows returned by RETURNING.
+func (sqlite) ordersReturning() bool { return false }
+
+// firstInsertID subtracts the rest of the rows from the last insert id, as
+// the ids of the rows inserted by a single statement are consecutive, since
+// the database is locked while it runs.
+func (sqlite) firstInsertID(lastInsertID int64, rows int) int64 {
+	return lastInsertID - int64(rows) + 1
+}
+
+// aliasedTable uses AS, since SQLite does not accept aliases without it in
+// UPDATE and DELETE statements.
+func (sqlite) aliasedTable(table, alias string) string { return table + " AS " + alias }
+
+func (sqlite) countsChangedRows() bool { return false }
+
+func (sqlite) currentTimestamp() string { return "CURRENT_TIMESTAMP" }
+
+// maxParams is the limit of SQLite 3.32.0 and newer, older versions only
+// allow 999 parameters.
+func (sqlite) maxParams() int { return 32766 }
+
+// LIKE is already case insensitive for ASCII characters in SQLite.
+var sqliteOperators = map[string]string{
+	opIlike:        ":col: LIKE :arg:",
+	opJSONIsObject: "json_type(:col:) = 'object'",
+	opJSONIsArray:  "json_type(:col:) = 'array'",
+}
+
+func (sqlite) operator(name string) (string, bool) {
+	format, ok := sqliteOperators[name]
+	return format, ok
+}
+
+// upsertClause returns the same clause as PostgreSQL, as SQLite supports the
+// same ON CONFLICT syntax.
+func (sqlite) upsertClause(schema Schema, conflictCols, updateCols []SchemaField) string {
+	return PostgreSQL.upsertClause(schema, conflictCols, updateCols)
+}
+
+// column stores arrays and JSON as JSON text, which is what the JSON1
+// functions expect.
+func (sqlite) column(v interface{}) interface{} {
+	if arr, ok := types.JSONArray(v); ok {
+		return &jsonText{arr}
+	}
+
+	if types.IsJSON(v) {
+		return &jsonText{v.(types.SQLType)}
+	}
+
+	return v
+}
+
+func (sqlite) restartsTransactions() bool { return false }
+
+func (sqlite) supportsCopy() bool { return false }
+
+func (sqlite) supportsCursors() bool { return false }
+
+func (sqlite) supportsLocking() bool { return false }
+
+func (sqlite) nullsLast() bool { return false }
+
+type cockroachDB struct {
+	postgreSQL
+}
+
+func (cockroachDB) Name() string { return "cockroachdb" }
+
+func (cockroachDB) restartsTransactions() bool { return true }
+
+// nullsLast is false, as CockroachDB sorts NULL values first in ascending
+// order, unlike PostgreSQL.
+func (cockroachDB) nullsLast() bool { return false }
+
+// jsonText is a JSON value that is converted to a string instead of bytes,
+// so it is not stored as a blob.
+type jsonText struct {
+	types.SQLType
+}
+
+func (j *jsonText) Value() (driver.Value, error) {
+	v, err := j.SQLType.Value()
+	if b, ok := v.([]byte); ok {
+		return string(b), err
+	}
+	return v, err
+}
+
+// dialectSchema is a schema carrying the dialect its conditions are
+// compiled to.
+type dialectSchema struct {
+	Schema
+	dialect Dialect
+}
+
+// withDialect returns the given schema with the given dialect, which will be
+// used by the conditions and fields compiled with it.
+func withDialect(schema Schema, dialect Dialect) Schema {
+	if s, ok := schema.(*dialectSchema); ok {
+		schema = s.Schema
+	}
+
+	return &dialectSchema{schema, dialect}
+}
+
+// dialectOf returns the dialect of the given schema, which is PostgreSQL
+// unless it was given one with withDialect.
+func dialectOf(schema Schema) Dialect {
+	if s, ok := schema.(*dialectSchema); ok {
+		return s.dialect
+	}
+	return PostgreSQL
+}
+
+// operatorError is the error returned by the operators not supported by a
+// dialect.
+type operatorError struct {
+	op      string
+	dialect string
+}
+
+func (e *operatorError) Error() string {
+	return fmt.Sprintf("kallax: operator %s is not supported by the %s dialect", e.op, e.dialect)
+}
+
+// Cause returns ErrUnsupportedOperator.
+func (e *operatorError) Cause() error {
+	return ErrUnsupportedOperator
+}
+
+// dialectOp returns the operation of the operator with the given name
+// applied to the given column and values in the dialect of the schema.
+func dialectOp(schema Schema, name string, col SchemaField, values ...interface{}) ToSqler {
+	dialect := dialectOf(schema)
+	format, ok := dialect.operator(name)
+	if !ok {
+		return &errOp{&operatorError{name, dialect.Name()}}
+	}
+
+	return newCustomOp(format, col.QualifiedName(schema), values, false)
+}
@@ -0,0 +1,396 @@
+package kallax
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+func TestDialectQuoteIdentifier(t *testing.T) {
+	require := require.New(t)
+	require.Equal(`"foo"`, PostgreSQL.QuoteIdentifier("foo"))
+	require.Equal("`foo`", MySQL.QuoteIdentifier("foo"))
+	require.Equal("`fo``o`", MySQL.QuoteIdentifier("fo`o"))
+	require.Equal(`"fo""o"`, SQLite.QuoteIdentifier(`fo"o`))
+}
+
+func TestMySQLJSONPath(t *testing.T) {
+	var cases = []struct {
+		name     string
+		key      *JSONSchemaKey
+		expected string
+	}{
+		{
+			"json text key",
+			NewJSONSchemaKey(JSONText, "foo", "bar", "baz"),
+			`JSON_UNQUOTE(JSON_EXTRACT(__model.foo, '$."bar"."baz"'))`,
+		},
+		{
+			"json int key",
+			NewJSONSchemaKey(JSONInt, "foo", "bar", "0"),
+			`CAST(JSON_UNQUOTE(JSON_EXTRACT(__model.foo, '$."bar"[0]')) AS SIGNED)`,
+		},
+		{
+			"json any key",
+			NewJSONSchemaKey(JSONAny, "foo", "bar"),
+			`JSON_EXTRACT(__model.foo, '$."bar"')`,
+		},
+		{
+			"json bool key",
+			NewJSONSchemaKey(JSONBool, "foo", "bar"),
+			`JSON_EXTRACT(__model.foo, '$."bar"')`,
+		},
+	}
+
+	r := require.New(t)
+	schema := withDialect(ModelSchema, MySQL)
+	for _, c := range cases {
+		r.Equal(c.expected, c.key.QualifiedName(schema), c.name)
+	}
+}
+
+func TestSQLiteJSONPath(t *testing.T) {
+	var cases = []struct {
+		name     string
+		key      *JSONSchemaKey
+		expected string
+	}{
+		{
+			"json text key",
+			NewJSONSchemaKey(JSONText, "foo", "bar", "baz"),
+			`json_extract(__model.foo, '$."bar"."baz"')`,
+		},
+		{
+			"json int key",
+			NewJSONSchemaKey(JSONInt, "foo", "bar", "0"),
+			`CAST(json_extract(__model.foo, '$."bar"[0]') AS INTEGER)`,
+		},
+		{
+			"json float key",
+			NewJSONSchemaKey(JSONFloat, "foo", "bar"),
+			`CAST(json_extract(__model.foo, '$."bar"') AS REAL)`,
+		},
+		{
+			"json bool key",
+			NewJSONSchemaKey(JSONBool, "foo", "bar"),
+			`json_extract(__model.foo, '$."bar"')`,
+		},
+	}
+
+	r := require.New(t)
+	schema := withDialect(ModelSchema, SQLite)
+	for _, c := range cases {
+		r.Equal(c.expected, c.key.QualifiedName(schema), c.name)
+	}
+}
+
+func TestSQLiteColumn(t *testing.T) {
+	require := require.New(t)
+
+	strs := []string{"a", "b"}
+	v, err := SQLite.column(types.Slice(&strs)).(driver.Valuer).Value()
+	require.NoError(err)
+	require.Equal(`["a","b"]`, v)
+
+	v, err = SQLite.column(types.JSON(map[string]int{"a": 1})).(driver.Valuer).Value()
+	require.NoError(err)
+	require.Equal(`{"a":1}`, v)
+
+	var dest []string
+	require.NoError(SQLite.column(types.Slice(&dest)).(sql.Scanner).Scan(`["c"]`))
+	require.Equal([]string{"c"}, dest)
+
+	require.Equal("foo", SQLite.column("foo"))
+	require.Equal(types.Slice(&strs), PostgreSQL.column(types.Slice(&strs)))
+}
+
+func TestCockroachDB(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("cockroachdb", CockroachDB.Name())
+	require.True(CockroachDB.restartsTransactions())
+	require.False(PostgreSQL.restartsTransactions())
+	require.Equal(PostgreSQL.PlaceholderFormat(), CockroachDB.PlaceholderFormat())
+
+	sql, _, err := ArrayContains(f("name"), "foo")(withDialect(ModelSchema, CockroachDB)).ToSql()
+	require.NoError(err)
+	require.Equal("__model.name @> ?", sql)
+}
+
+func TestDialectOf(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(PostgreSQL, dialectOf(ModelSchema))
+	require.Equal(PostgreSQL, dialectOf(nil))
+
+	schema := withDialect(withDialect(ModelSchema, PostgreSQL), MySQL)
+	require.Equal(MySQL, dialectOf(schema))
+	require.Equal(ModelSchema, schema.(*dialectSchema).Schema)
+}
+
+func TestBaseQuery_CompileMySQL(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"))
+	q.Where(Eq(f("name"), "foo"))
+	q.Where(JSONContains(f("name"), "bar"))
+	q.Order(Asc(NewJSONSchemaKey(JSONText, "foo", "bar")))
+
+	_, builder := q.compile(MySQL)
+	sql, args, err := builder.ToSql()
+	require.NoError(err)
+	require.Equal(
+		"SELECT __model.name FROM model __model WHERE __model.name = ? AND JSON_CONTAINS(__model.name, ?) "+
+			`ORDER BY JSON_UNQUOTE(JSON_EXTRACT(__model.foo, '$."bar"')) ASC`,
+		sql,
+	)
+	require.Len(args, 2)
+
+	_, builder = q.compile(PostgreSQL)
+	sql, _, err = builder.ToSql()
+	require.NoError(err)
+	require.Equal(
+		"SELECT __model.name FROM model __model WHERE __model.name = $1 AND __model.name @> $2 "+
+			"ORDER BY __model.foo #>>'{bar}' ASC",
+		sql,
+	)
+}
+
+func TestDialect_UnsupportedOperator(t *testing.T) {
+	require := require.New(t)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(ArrayContains(f("name"), "foo"))
+
+	_, builder := q.compile(MySQL)
+	_, _, err := builder.ToSql()
+	require.EqualError(err, "kallax: operator array_contains is not supported by the mysql dialect")
+	require.Equal(ErrUnsupportedOperator, err.(causer).Cause())
+
+	_, builder = q.compile(SQLite)
+	_, _, err = builder.ToSql()
+	require.EqualError(err, "kallax: operator array_contains is not supported by the sqlite dialect")
+
+	_, builder = q.compile(PostgreSQL)
+	_, _, err = builder.ToSql()
+	require.NoError(err)
+}
+
+func TestDialect_JSONContainsAny(t *testing.T) {
+	require := require.New(t)
+
+	cond := JSONContainsAny(f("name"), 1, 2)
+	sql, args, err := cond(withDialect(ModelSchema, MySQL)).ToSql()
+	require.NoError(err)
+	require.Equal("(JSON_CONTAINS(__model.name, ?) OR JSON_CONTAINS(__model.name, ?))", sql)
+	require.Len(args, 2)
+
+	sql, _, err = cond(ModelSchema).ToSql()
+	require.NoError(err)
+	require.Equal("__model.name @> ANY (ARRAY [?, ?]::jsonb[])", sql)
+}
+
+func TestUpsertClause(t *testing.T) {
+	require := require.New(t)
+
+	conflict := []SchemaField{f("email")}
+	update := []SchemaField{f("name"), f("age")}
+	require.Equal(
+		`ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name", "age" = EXCLUDED."age"`,
+		PostgreSQL.upsertClause(ModelSchema, conflict, update),
+	)
+	require.Equal(
+		"ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`), `age` = VALUES(`age`)",
+		MySQL.upsertClause(ModelSchema, conflict, update),
+	)
+	require.Equal(
+		"ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`)",
+		MySQL.upsertClause(ModelSchema, conflict, nil),
+	)
+	require.Equal(
+		PostgreSQL.upsertClause(ModelSchema, conflict, update),
+		SQLite.upsertClause(ModelSchema, conflict, update),
+	)
+}
+
+type fakeResult struct {
+	id, rows int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.id, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+func TestStoreWithDialect_Insert(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeProxy{result: fakeResult{42, 1}}
+	store := (&Store{runner: fake, dialect: MySQL}).build()
+
+	m := newModel("foo", "bar", 1)
+	require.NoError(store.Insert(ModelSchema, m))
+	require.Equal(int64(42), m.ID)
+	require.True(m.IsPersisted())
+	require.Equal([]string{"INSERT INTO model (name,email,age) VALUES (?,?,?)"}, fake.queries)
+
+	m1, m2 := newModel("foo", "bar", 1), newModel("baz", "qux", 2)
+	require.NoError(store.InsertMany(ModelSchema, m1, m2))
+	require.Equal(int64(42), m1.ID)
+	require.Equal(int64(43), m2.ID)
+}
+
+func TestStoreWithDialect_InsertManySQLite(t *testing.T) {
+	require := require.New(t)
+
+	// the last insert id of SQLite is the one of the last row inserted
+	fake := &fakeProxy{result: fakeResult{43, 2}}
+	store := (&Store{runner: fake, dialect: SQLite}).build()
+
+	m1, m2 := newModel("foo", "bar", 1), newModel("baz", "qux", 2)
+	require.NoError(store.InsertMany(ModelSchema, m1, m2))
+	require.Equal(int64(42), m1.ID)
+	require.Equal(int64(43), m2.ID)
+	require.True(m1.IsPersisted())
+	require.Equal([]string{"INSERT INTO model (name,email,age) VALUES (?,?,?),(?,?,?)"}, fake.queries)
+}
+
+func TestStoreWithDialect_UpdateUnchanged(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeProxy{result: fakeResult{0, 0}, row: fakeRow(func(...interface{}) error {
+		return nil
+	})}
+	store := (&Store{runner: fake, dialect: MySQL}).build()
+
+	m := newModel("foo", "bar", 1)
+	m.ID = 42
+	m.setPersisted()
+	m.setWritable(true)
+
+	updated, err := store.Update(ModelSchema, m)
+	require.NoError(err)
+	require.Equal(int64(1), updated)
+	require.Equal([]string{
+		"UPDATE model SET age = ?, email = ?, id = ?, name = ? WHERE id = ?",
+		"SELECT 1 FROM model WHERE id = ?",
+	}, fake.queries)
+
+	fake.row = fakeRow(func(...interface{}) error {
+		return sql.ErrNoRows
+	})
+	_, err = store.Update(ModelSchema, m)
+	require.Equal(ErrNoRowUpdate, err)
+}
+
+func TestStoreWithDialect_UpsertUnchanged(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeProxy{result: fakeResult{0, 0}, row: fakeRow(func(dest ...interface{}) error {
+		return setInt64(dest[0], 42)
+	})}
+	store := (&Store{runner: fake, dialect: MySQL}).build()
+
+	m := newModel("foo", "bar", 1)
+	require.NoError(store.Upsert(ModelSchema, m, []SchemaField{f("email")}, f("name")))
+	require.Equal(int64(42), m.ID)
+	require.True(m.IsPersisted())
+	require.Equal("SELECT id FROM model WHERE email = ?", fake.queries[1])
+
+	// the conflicting row is left untouched without update columns
+	fake.queries = nil
+	m = newModel("foo", "bar", 1)
+	require.NoError(store.Upsert(ModelSchema, m, nil))
+	require.False(m.IsPersisted())
+	require.Len(fake.queries, 1)
+}
+
+func TestStoreWithDialect_SoftDelete(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeProxy{result: fakeResult{0, 2}, row: fakeRow(func(...interface{}) error {
+		return nil
+	})}
+	store := (&Store{runner: fake, dialect: SQLite}).build()
+	schema := ModelSchema.WithSoftDelete(f("age"))
+
+	m := newModel("foo", "bar", 1)
+	m.ID = 42
+	m.setPersisted()
+	m.setWritable(true)
+	require.NoError(store.Delete(schema, m))
+
+	q := NewBaseQuery(schema)
+	q.Where(Eq(f("name"), "foo"))
+	deleted, err := store.DeleteAll(q)
+	require.NoError(err)
+	require.Equal(int64(2), deleted)
+
+	require.Len(fake.queries, 2)
+	require.Contains(fake.queries[0], "UPDATE model SET age = CURRENT_TIMESTAMP WHERE ")
+	require.Equal(
+		"UPDATE model AS __model SET age = CURRENT_TIMESTAMP WHERE __model.name = ? AND __model.age IS NULL",
+		fake.queries[1],
+	)
+}
+
+func TestStoreWithDialect_DeleteAll(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeProxy{result: fakeResult{0, 2}}
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("name"), "foo"))
+
+	// SQLite does not accept aliases without AS
+	store := (&Store{runner: fake, dialect: SQLite}).build()
+	_, err := store.DeleteAll(q)
+	require.NoError(err)
+	_, err = store.UpdateAll(q, map[SchemaField]interface{}{f("age"): 2})
+	require.NoError(err)
+
+	store = (&Store{runner: fake, dialect: MySQL}).build()
+	_, err = store.DeleteAll(q)
+	require.NoError(err)
+
+	require.Equal([]string{
+		"DELETE FROM model AS __model WHERE __model.name = ?",
+		"UPDATE model AS __model SET age = ? WHERE __model.name = ?",
+		"DELETE FROM model __model WHERE __model.name = ?",
+	}, fake.queries)
+}
+
+func TestInsertChunkSize(t *testing.T) {
+	require := require.New(t)
+	require.Equal(10, insertChunkSize(PostgreSQL, 10, 3))
+	require.Equal(21845, insertChunkSize(PostgreSQL, 30000, 3))
+	require.Equal(21845, insertChunkSize(MySQL, 30000, 3))
+	require.Equal(10922, insertChunkSize(SQLite, 30000, 3))
+	require.Equal(30000, insertChunkSize(SQLite, 30000, 0))
+}
+
+func TestSetInt64(t *testing.T) {
+	require := require.New(t)
+
+	var i int32
+	require.NoError(setInt64(&i, 5))
+	require.Equal(int32(5), i)
+
+	var u uint
+	require.NoError(setInt64(&u, 5))
+	require.Equal(uint(5), u)
+
+	var id NumericID
+	require.NoError(setInt64(&id, 5))
+	require.Equal(NumericID(5), id)
+
+	var s string
+	require.Error(setInt64(&s, 5))
+
+	require.NoError(incrementVersion(&i))
+	require.Equal(int32(6), i)
+	require.Error(incrementVersion(&s))
+}
+
+var _ driver.Result = fakeResult{}
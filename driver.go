@@ -0,0 +1,120 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"io"
+)
+
+// NativeRows are the rows of a query read natively by the driver of a
+// store, instead of as *sql.Rows.
+type NativeRows interface {
+	// Next prepares the next row for reading and returns false if there are
+	// no more rows.
+	Next() bool
+	// Scan copies the columns of the current row into the given values.
+	Scan(dest ...interface{}) error
+	// Columns returns the names of the columns of the rows.
+	Columns() ([]string, error)
+	// Err returns the error, if any, that was encountered while reading the
+	// rows.
+	Err() error
+	// Close closes the rows, preventing further reading.
+	Close() error
+	// Column returns the given column address converted to the one the rows
+	// can decode natively into, or the same address if there is none. It is
+	// called with the addresses of the columns of the records before they
+	// are scanned, except the ones of their relationships.
+	Column(ptr interface{}) interface{}
+}
+
+// NativeDriver runs natively the statements of a store that do not need to
+// go through database/sql, that is, the queries of the result sets returned
+// by the store and the COPY statements, outside transactions.
+type NativeDriver interface {
+	// Query runs the given query and returns its rows.
+	Query(ctx context.Context, query string, args ...interface{}) (NativeRows, error)
+	// CopyFrom runs the given COPY FROM statement in its own transaction,
+	// reading the data to copy, in the text format of COPY, from the given
+	// reader, and returns the number of rows copied. The transaction must
+	// be rolled back if the reader fails with an error other than io.EOF.
+	CopyFrom(ctx context.Context, query string, r io.Reader) (int64, error)
+	// CopyTo runs the given COPY TO statement, writing the data to the given
+	// writer, and returns the number of rows copied.
+	CopyTo(ctx context.Context, query string, w io.Writer) (int64, error)
+}
+
+// NewStoreWithNativeDriver returns a new Store instance for a PostgreSQL
+// database, whose result sets and COPY statements are run with the given
+// native driver instead of with the given database. The rest of the
+// statements, as well as the transactions and raw queries, are run with the
+// database, so both must be connected to the same one.
+// See the pgxstore package for a store whose rows are read natively by pgx.
+func NewStoreWithNativeDriver(db *sql.DB, driver NativeDriver) *Store {
+	s := &Store{
+		db:      db,
+		dialect: PostgreSQL,
+		runner:  &nativeProxy{newStmtCacher(db), driver},
+	}
+	return s.build()
+}
+
+// nativeProxy is a database proxy that runs the queries whose rows can be
+// read natively and the COPY statements with a native driver, and the rest
+// of the statements in the wrapped proxy.
+type nativeProxy struct {
+	dbProxy
+	driver NativeDriver
+}
+
+func (p *nativeProxy) queryRows(ctx context.Context, query string, args []interface{}) (resultRows, error) {
+	rows, err := p.driver.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (p *nativeProxy) copyFrom(ctx context.Context, query string, src copySource) (int64, error) {
+	return p.driver.CopyFrom(ctx, query, &copyReader{ctx: ctx, src: src})
+}
+
+func (p *nativeProxy) copyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	return p.driver.CopyTo(ctx, query, w)
+}
+
+// copyReader is a reader of the rows of a copy source in the text format of
+// COPY. It fails with the error of the source, or the one of the context
+// once it is done.
+type copyReader struct {
+	ctx context.Context
+	src copySource
+	// row is the current row, of which the bytes before pos have been read.
+	row []byte
+	pos int
+	err error
+}
+
+func (r *copyReader) Read(p []byte) (int, error) {
+	for r.pos == len(r.row) {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		if r.err = r.ctx.Err(); r.err != nil {
+			return 0, r.err
+		}
+
+		var values []interface{}
+		if values, r.err = r.src(); r.err != nil {
+			return 0, r.err
+		}
+
+		r.row = appendCopyRow(r.row[:0], values)
+		r.pos = 0
+	}
+
+	n := copy(p, r.row[r.pos:])
+	r.pos += n
+	return n, nil
+}
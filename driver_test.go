@@ -0,0 +1,152 @@
+package kallax
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDriver struct {
+	rows    NativeRows
+	queries []string
+	copied  string
+	err     error
+}
+
+func (d *fakeDriver) Query(ctx context.Context, query string, args ...interface{}) (NativeRows, error) {
+	d.queries = append(d.queries, query)
+	return d.rows, d.err
+}
+
+func (d *fakeDriver) CopyFrom(ctx context.Context, query string, r io.Reader) (int64, error) {
+	d.queries = append(d.queries, query)
+	data, err := ioutil.ReadAll(r)
+	d.copied = string(data)
+	return 0, err
+}
+
+func (d *fakeDriver) CopyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	d.queries = append(d.queries, query)
+	_, err := io.WriteString(w, d.copied)
+	return 1, err
+}
+
+// fakeNativeRows are native rows with a single row, whose name column is
+// decoded into a byte slice.
+type fakeNativeRows struct {
+	fakeRows
+	read bool
+}
+
+func (r *fakeNativeRows) Next() bool {
+	next := !r.read
+	r.read = true
+	return next
+}
+
+func (r *fakeNativeRows) Scan(dest ...interface{}) error {
+	*dest[0].(*int64) = 1
+	*dest[1].(*[]byte) = []byte("Joe")
+	return nil
+}
+
+func (r *fakeNativeRows) Column(ptr interface{}) interface{} {
+	if _, ok := ptr.(*string); ok {
+		return new([]byte)
+	}
+	return ptr
+}
+
+func TestCopyReader(t *testing.T) {
+	require := require.New(t)
+
+	rows := [][]interface{}{{"foo", nil}, {"a\tb", "1"}}
+	r := &copyReader{ctx: context.Background(), src: func() ([]interface{}, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+
+		row := rows[0]
+		rows = rows[1:]
+		return row, nil
+	}}
+
+	// it is read in small chunks to check rows are read across reads
+	var data []byte
+	buf := make([]byte, 3)
+	for {
+		n, err := r.Read(buf)
+		data = append(data, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+	}
+	require.Equal("foo\t\\N\na\\tb\t1\n", string(data))
+}
+
+func TestCopyReader_Fail(t *testing.T) {
+	require := require.New(t)
+
+	r := &copyReader{ctx: context.Background(), src: func() ([]interface{}, error) {
+		return nil, errors.New("foo")
+	}}
+	_, err := ioutil.ReadAll(r)
+	require.EqualError(err, "foo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = &copyReader{ctx: ctx, src: func() ([]interface{}, error) {
+		return []interface{}{"foo"}, nil
+	}}
+	_, err = ioutil.ReadAll(r)
+	require.Equal(context.Canceled, err)
+}
+
+func TestNativeProxy(t *testing.T) {
+	require := require.New(t)
+
+	driver := &fakeDriver{rows: &fakeNativeRows{}}
+	proxy := &nativeProxy{&fakeProxy{}, driver}
+
+	rows, err := queryRows(context.Background(), proxy, "SELECT 1", nil)
+	require.NoError(err)
+	require.Equal(driver.rows, rows)
+
+	driver.err = errors.New("foo")
+	_, err = queryRows(context.Background(), proxy, "SELECT 1", nil)
+	require.EqualError(err, "foo")
+
+	values := []interface{}{"foo", nil}
+	_, err = copyFrom(context.Background(), proxy, "COPY model FROM STDIN", func() ([]interface{}, error) {
+		if values == nil {
+			return nil, io.EOF
+		}
+
+		row := values
+		values = nil
+		return row, nil
+	})
+	require.NoError(err)
+	require.Equal("foo\t\\N\n", driver.copied)
+	require.Equal([]string{"SELECT 1", "SELECT 1", "COPY model FROM STDIN"}, driver.queries)
+}
+
+func TestBaseResultSet_NativeRows(t *testing.T) {
+	require := require.New(t)
+
+	rs := newResultSet(&fakeNativeRows{}, false, nil, "id", "name")
+	require.Nil(rs.Rows)
+	require.True(rs.Next())
+
+	// the name would fail to be scanned if its address was not converted
+	record, err := rs.Get(ModelSchema)
+	require.NoError(err)
+	require.Equal(int64(1), record.(*model).ID)
+	require.False(rs.Next())
+	require.NoError(rs.Close())
+}
@@ -26,6 +26,11 @@ var Migrate = cli.Command{
 			Usage: "Descriptive name for the migration",
 			Value: "migration",
 		},
+		cli.StringFlag{
+			Name:  "dialect",
+			Usage: "SQL dialect of the migrations: `postgresql`, `sqlite` or `cockroachdb`",
+			Value: string(generator.PostgreSQLDialect),
+		},
 		cli.StringSliceFlag{
 			Name:  "input, i",
 			Usage: "List of directories to scan models from. You can use this flag as many times as you want.",
@@ -159,6 +164,12 @@ func migrateAction(c *cli.Context) error {
 	dirs := c.StringSlice("input")
 	dir := c.String("out")
 	name := c.String("name")
+	dialect := generator.Dialect(c.String("dialect"))
+	switch dialect {
+	case generator.PostgreSQLDialect, generator.SQLiteDialect, generator.CockroachDBDialect:
+	default:
+		return fmt.Errorf("kallax: unknown dialect %q", dialect)
+	}
 
 	var pkgs []*generator.Package
 	for _, dir := range dirs {
@@ -190,7 +201,7 @@ func migrateAction(c *cli.Context) error {
 		return fmt.Errorf("kallax: `out` must be a valid directory")
 	}
 
-	g := generator.NewMigrationGenerator(name, dir)
+	g := generator.NewMigrationGeneratorWithDialect(name, dir, dialect)
 	migration, err := g.Build(pkgs...)
 	if err != nil {
 		return err
@@ -64,9 +64,10 @@ type Timestamper func() time.Time
 
 // MigrationGenerator is a generator of migrations.
 type MigrationGenerator struct {
-	name string
-	dir  string
-	now  Timestamper
+	name    string
+	dir     string
+	now     Timestamper
+	dialect Dialect
 }
 
 type migrationFileType string
@@ -80,7 +81,13 @@ const (
 // NewMigrationGenerator returns a new migration generator with the given
 // migrations directory.
 func NewMigrationGenerator(name, dir string) *MigrationGenerator {
-	return &MigrationGenerator{slugify(name), dir, time.Now}
+	return NewMigrationGeneratorWithDialect(name, dir, PostgreSQLDialect)
+}
+
+// NewMigrationGeneratorWithDialect returns a new migration generator with the
+// given migrations directory that generates migrations for the given dialect.
+func NewMigrationGeneratorWithDialect(name, dir string, dialect Dialect) *MigrationGenerator {
+	return &MigrationGenerator{slugify(name), dir, time.Now, dialect}
 }
 
 // Build creates a new migration from a set of scanned packages.
@@ -90,7 +97,7 @@ func (g *MigrationGenerator) Build(pkgs ...*Package) (*Migration, error) {
 		return nil, err
 	}
 
-	new, err := SchemaFromPackages(pkgs...)
+	new, err := SchemaFromPackagesWithDialect(g.dialect, pkgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +52,30 @@ type DBSchema struct {
 
 // SchemaFromPackages returns a schema for the given packages models.
 func SchemaFromPackages(pkgs ...*Package) (*DBSchema, error) {
+	return SchemaFromPackagesWithDialect(PostgreSQLDialect, pkgs...)
+}
+
+// SchemaFromPackagesWithDialect returns a schema for the given packages
+// models with the column types of the given dialect.
+func SchemaFromPackagesWithDialect(dialect Dialect, pkgs ...*Package) (*DBSchema, error) {
 	t := newPackageTransformer()
+	t.dialect = dialect
 	return t.transform(pkgs...)
 }
 
+// Dialect is the SQL dialect of the database migrations are generated for.
+type Dialect string
+
+const (
+	// PostgreSQLDialect is the dialect of PostgreSQL.
+	PostgreSQLDialect Dialect = "postgresql"
+	// SQLiteDialect is the dialect of SQLite.
+	SQLiteDialect Dialect = "sqlite"
+	// CockroachDBDialect is the dialect of CockroachDB, which uses the
+	// PostgreSQL types, except for serial columns.
+	CockroachDBDialect Dialect = "cockroachdb"
+)
+
 func (s *DBSchema) MarshalText() ([]byte, error) {
 	schema := struct {
 		Tables []*TableSchema
@@ -156,6 +176,8 @@ type ColumnSchema struct {
 	Reference *Reference
 	// NotNull reports whether the column is not nullable.
 	NotNull bool
+	// Default is the optional default value of the column.
+	Default string
 }
 
 func (s *ColumnSchema) Equals(s2 *ColumnSchema) bool {
@@ -163,6 +185,7 @@ func (s *ColumnSchema) Equals(s2 *ColumnSchema) bool {
 		s.Type == s2.Type &&
 		s.PrimaryKey == s2.PrimaryKey &&
 		s.NotNull == s2.NotNull &&
+		s.Default == s2.Default &&
 		s.Reference.Equals(s2.Reference)
 }
 
@@ -176,6 +199,11 @@ func (s *ColumnSchema) String() string {
 		buf.WriteString(" NOT NULL")
 	}
 
+	if s.Default != "" {
+		buf.WriteString(" DEFAULT ")
+		buf.WriteString(s.Default)
+	}
+
 	if s.PrimaryKey {
 		buf.WriteString(" PRIMARY KEY")
 	}
@@ -205,6 +233,8 @@ const (
 	JSONBColumn       ColumnType = "jsonb"
 	BooleanColumn     ColumnType = "boolean"
 	UUIDColumn        ColumnType = "uuid"
+	DatetimeColumn    ColumnType = "datetime"
+	Int8Column        ColumnType = "int8"
 )
 
 func NumericColumn(precision int) ColumnType {
@@ -634,6 +664,12 @@ func ColumnSchemaDiff(table string, old, new *ColumnSchema) ChangeSet {
 		})
 	}
 
+	if old.Default != new.Default {
+		cs = append(cs, &ManualChange{
+			fmt.Sprintf("don't know how to generate migration for a change of default value in %s(%s)", table, new.Name),
+		})
+	}
+
 	if referenceChanged(old, new) {
 		cs = append(cs, &ManualChange{
 			fmt.Sprintf("don't know how to generate migration for a change of foreign key in %s(%s)", table, new.Name),
@@ -654,6 +690,8 @@ func referenceChanged(old, new *ColumnSchema) bool {
 type packageTransformer struct {
 	// pkg is the current package being transformed.
 	pkg *Package
+	// dialect is the dialect of the column types.
+	dialect Dialect
 	// schema is the final schema being built.
 	schema *DBSchema
 
@@ -669,6 +707,7 @@ type packageTransformer struct {
 
 func newPackageTransformer() *packageTransformer {
 	return &packageTransformer{
+		dialect:    PostgreSQLDialect,
 		schema:     new(DBSchema),
 		tables:     make(map[string]*TableSchema),
 		tableIndex: make(map[string]string),
@@ -800,13 +839,27 @@ func (t *packageTransformer) transformField(f *Field) (*ColumnSchema, error) {
 		name = f.ForeignKey()
 	}
 
-	return &ColumnSchema{
+	column := &ColumnSchema{
 		Name:       name,
 		PrimaryKey: f.IsPrimaryKey(),
 		NotNull:    false,
 		Type:       typ,
 		Reference:  ref,
-	}, nil
+	}
+
+	if f.IsVersion() {
+		column.NotNull = true
+		column.Default = "0"
+	}
+
+	if t.dialect == CockroachDBDialect && isSerialColumn(column.Type) {
+		// serial columns are not sequential in CockroachDB, so the unique_rowid
+		// default they would get is made explicit
+		column.Type = Int8Column
+		column.Default = "unique_rowid()"
+	}
+
+	return column, nil
 }
 
 func (t *packageTransformer) transformType(f *Field, pk bool) (ColumnType, error) {
@@ -814,6 +867,15 @@ func (t *packageTransformer) transformType(f *Field, pk bool) (ColumnType, error
 		return ColumnType(typ), nil
 	}
 
+	typ, err := t.transformPostgreSQLType(f, pk)
+	if err != nil || t.dialect != SQLiteDialect {
+		return typ, err
+	}
+
+	return sqliteColumnType(typ), nil
+}
+
+func (t *packageTransformer) transformPostgreSQLType(f *Field, pk bool) (ColumnType, error) {
 	if f.IsJSON {
 		return JSONBColumn, nil
 	}
@@ -904,6 +966,41 @@ var typeMappings = map[string]ColumnType{
 	"time.Duration": BigIntColumn,
 }
 
+func isSerialColumn(typ ColumnType) bool {
+	return typ == SmallSerialColumn || typ == SerialColumn || typ == BigSerialColumn
+}
+
+// sqliteTypeMappings maps the PostgreSQL column types to the SQLite ones.
+// Types not in the map are the same in both.
+var sqliteTypeMappings = map[ColumnType]ColumnType{
+	SmallIntColumn:    IntegerColumn,
+	BigIntColumn:      IntegerColumn,
+	SmallSerialColumn: IntegerColumn,
+	SerialColumn:      IntegerColumn,
+	BigSerialColumn:   IntegerColumn,
+	NumericColumn(20): IntegerColumn,
+	DoubleColumn:      RealColumn,
+	TimestamptzColumn: DatetimeColumn,
+	JSONBColumn:       TextColumn,
+	UUIDColumn:        TextColumn,
+	"char(1)":         TextColumn,
+}
+
+// sqliteColumnType returns the SQLite column type of the given PostgreSQL
+// type. Arrays are stored as JSON, so they are text columns, and all the
+// serial types are integer, which makes a primary key an alias of the rowid
+// and, thus, auto incremented.
+func sqliteColumnType(typ ColumnType) ColumnType {
+	if strings.HasSuffix(string(typ), "[]") {
+		return TextColumn
+	}
+
+	if t, ok := sqliteTypeMappings[typ]; ok {
+		return t
+	}
+	return typ
+}
+
 var idTypeMappings = map[string]ColumnType{
 	"kallax.ULID":      UUIDColumn,
 	"kallax.UUID":      UUIDColumn,
@@ -55,6 +55,15 @@ func TestArrayColumn(t *testing.T) {
 	require.Equal(t, ColumnType("text[]"), ArrayColumn(ArrayColumn(TextColumn)))
 }
 
+func TestSQLiteColumnType(t *testing.T) {
+	require := require.New(t)
+	require.Equal(IntegerColumn, sqliteColumnType(NumericColumn(20)))
+	require.Equal(DatetimeColumn, sqliteColumnType(TimestamptzColumn))
+	require.Equal(TextColumn, sqliteColumnType(ArrayColumn(BigIntColumn)))
+	require.Equal(BooleanColumn, sqliteColumnType(BooleanColumn))
+	require.Equal(ColumnType("varchar(6)"), sqliteColumnType(ColumnType("varchar(6)")))
+}
+
 func TestChangeSet(t *testing.T) {
 	assertChange(
 		t,
@@ -195,6 +204,12 @@ func TestColumnSchemaDiff(t *testing.T) {
 			mkCol("foo", TextColumn, false, false, nil),
 			true,
 		},
+		{
+			"default change",
+			withDefault(mkCol("foo", BigIntColumn, false, true, nil), "0"),
+			mkCol("foo", BigIntColumn, false, true, nil),
+			true,
+		},
 		{
 			"ref added",
 			mkCol("foo", TextColumn, false, false, nil),
@@ -476,6 +491,8 @@ type ProfileMetadata struct {
 	ID int64 ` + "`pk:\"autoincr\"`" + `
 	// a json field
 	Metadata map[string]interface{}
+	// a version field, should be not null with a default
+	Version int64 ` + "`kallax:\",version\"`" + `
 }
 `
 
@@ -505,6 +522,7 @@ func (s *PackageTransformerSuite) TestTransform() {
 			"metadata",
 			mkCol("id", SerialColumn, true, false, nil),
 			mkCol("metadata", JSONBColumn, false, false, nil),
+			withDefault(mkCol("version", BigIntColumn, false, true, nil), "0"),
 			mkCol("profile_id", BigIntColumn, false, false, mkRef("profiles", "id")),
 		),
 		mkTable(
@@ -518,6 +536,61 @@ func (s *PackageTransformerSuite) TestTransform() {
 	require.Equal(expected, schema)
 }
 
+func (s *PackageTransformerSuite) TestTransform_SQLite() {
+	require := s.Require()
+	s.t.dialect = SQLiteDialect
+	schema, err := s.t.transform(s.pkg)
+	require.NoError(err)
+	require.NotNil(schema)
+
+	expected := mkSchema(
+		mkTable(
+			"profiles",
+			mkCol("id", IntegerColumn, true, false, nil),
+			mkCol("color", ColumnType("char(6)"), false, false, nil),
+			mkCol("background", TextColumn, false, false, nil),
+			mkCol("user_id", TextColumn, false, false, mkRef("users", "id")),
+			mkCol("spouse", TextColumn, false, false, nil),
+		),
+		mkTable(
+			"metadata",
+			mkCol("id", IntegerColumn, true, false, nil),
+			mkCol("metadata", TextColumn, false, false, nil),
+			withDefault(mkCol("version", IntegerColumn, false, true, nil), "0"),
+			mkCol("profile_id", IntegerColumn, false, false, mkRef("profiles", "id")),
+		),
+		mkTable(
+			"users",
+			mkCol("id", TextColumn, true, false, nil),
+			mkCol("username", TextColumn, false, false, nil),
+			mkCol("emails", TextColumn, false, false, nil),
+		),
+	)
+
+	require.Equal(expected, schema)
+}
+
+func (s *PackageTransformerSuite) TestTransform_CockroachDB() {
+	require := s.Require()
+	s.t.dialect = CockroachDBDialect
+	schema, err := s.t.transform(s.pkg)
+	require.NoError(err)
+
+	rowid := func(col *ColumnSchema) *ColumnSchema {
+		return withDefault(col, "unique_rowid()")
+	}
+
+	profiles := schema.Table("profiles")
+	require.Equal(rowid(mkCol("id", Int8Column, true, false, nil)), profiles.Column("id"))
+	require.Equal("id int8 DEFAULT unique_rowid() PRIMARY KEY", profiles.Column("id").String())
+	require.Equal(mkCol("user_id", UUIDColumn, false, false, mkRef("users", "id")), profiles.Column("user_id"))
+
+	metadata := schema.Table("metadata")
+	require.Equal(rowid(mkCol("id", Int8Column, true, false, nil)), metadata.Column("id"))
+	require.Equal(mkCol("metadata", JSONBColumn, false, false, nil), metadata.Column("metadata"))
+	require.Equal(mkCol("profile_id", BigIntColumn, false, false, mkRef("profiles", "id")), metadata.Column("profile_id"))
+}
+
 func (s *PackageTransformerSuite) TestApplyInverses_TableNotFound() {
 	s.t.fks["foo"] = []*ColumnSchema{
 		mkCol("foo", TextColumn, false, false, nil),
@@ -586,7 +659,12 @@ func mkTable(name string, columns ...*ColumnSchema) *TableSchema {
 }
 
 func mkCol(name string, typ ColumnType, pk, notNull bool, ref *Reference) *ColumnSchema {
-	return &ColumnSchema{name, typ, pk, ref, notNull}
+	return &ColumnSchema{name, typ, pk, ref, notNull, ""}
+}
+
+func withDefault(c *ColumnSchema, def string) *ColumnSchema {
+	c.Default = def
+	return c
 }
 
 func mkRef(table, col string) *Reference {
@@ -422,6 +422,26 @@ func (s *ProcessorSuite) TestIsEmbedded() {
 	}
 }
 
+func (s *ProcessorSuite) TestSoftDelete() {
+	src := `
+	package fixture
+
+	import "gopkg.in/src-d/go-kallax.v1"
+
+	type Foo struct {
+		kallax.Model
+		kallax.SoftDelete
+		ID int64 ` + "`pk:\"autoincr\"`" + `
+		Foo string
+	}
+	`
+	pkg := s.processFixture(src)
+	m := findModel(pkg, "Foo")
+	s.NoError(m.Validate())
+	s.NotNil(m.SoftDelete())
+	s.Equal("deleted_at", m.SoftDelete().ColumnName())
+}
+
 func TestProcessor(t *testing.T) {
 	suite.Run(t, new(ProcessorSuite))
 }
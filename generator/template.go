@@ -587,6 +587,54 @@ func (td *TemplateData) genFindBy(buf *bytes.Buffer, parent *Model, fields []*Fi
 	}
 }
 
+const (
+	// tplSumAvg is the template of the Sum and Avg autogenerated for numeric
+	// properties, which are always returned as float64.
+	tplSumAvg = `
+		// %[5]s%[1]s returns the %[6]s of the %[1]s property of the items
+		// selected by the given query, or zero if there are none.
+		func (s *%[2]s) %[5]s%[1]s(q *%[3]s) (float64, error) {
+			var v float64
+			err := s.Store.AggregateValue(q, kallax.%[5]s(Schema.%[4]s.%[1]s), &v)
+			return v, err
+		}`
+	// tplMinMax is the template of the Min and Max autogenerated for numeric
+	// properties, which are returned as the type of the property.
+	tplMinMax = `
+		// %[5]s%[1]s returns the %[6]s value of the %[1]s property of the
+		// items selected by the given query, or zero if there are none.
+		func (s *%[2]s) %[5]s%[1]s(q *%[3]s) (%[7]s, error) {
+			var v %[7]s
+			err := s.Store.AggregateValue(q, kallax.%[5]s(Schema.%[4]s.%[1]s), &v)
+			return v, err
+		}`
+)
+
+// GenAggregates generates SumPropertyName, AvgPropertyName, MinPropertyName
+// and MaxPropertyName for all model properties that are numbers.
+func (td *TemplateData) GenAggregates(model *Model) string {
+	var buf bytes.Buffer
+	td.genAggregates(&buf, model, model.Fields)
+	return buf.String()
+}
+
+func (td *TemplateData) genAggregates(buf *bytes.Buffer, parent *Model, fields []*Field) {
+	for _, f := range fields {
+		switch {
+		case f.Inline():
+			td.genAggregates(buf, parent, f.Fields)
+		case f.IsPrimaryKey() || !isNumeric(f):
+			continue
+		default:
+			store, query, model := parent.StoreName, parent.QueryName, parent.Name
+			buf.WriteString(fmt.Sprintf(tplSumAvg, f.Name, store, query, model, "Sum", "sum"))
+			buf.WriteString(fmt.Sprintf(tplSumAvg, f.Name, store, query, model, "Avg", "average"))
+			buf.WriteString(fmt.Sprintf(tplMinMax, f.Name, store, query, model, "Min", "minimum", f.Type))
+			buf.WriteString(fmt.Sprintf(tplMinMax, f.Name, store, query, model, "Max", "maximum", f.Type))
+		}
+	}
+}
+
 func writeFindByTpl(buf *bytes.Buffer, parent *Model, name string, f *Field, tpl string) {
 	findableTypeName, ok := findableTypeName(f)
 	if !ok {
@@ -713,6 +761,22 @@ func isSortable(f *Field) bool {
 	return f.Kind == Basic && !isEqualizable(f)
 }
 
+// isNumeric returns true if the autogenerated aggregates will be generated
+// for the field, which must be an int, uint or float of any size.
+func isNumeric(f *Field) bool {
+	if f.Kind != Basic {
+		return false
+	}
+
+	switch f.Type {
+	case "float64", "float32",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
 // isCollection returns true if the autogenerated FindBy will use an kallax.ArrayContains
 func isCollection(f *Field) bool {
 	return f.Kind == Slice || f.Kind == Array
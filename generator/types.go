@@ -293,6 +293,18 @@ func (m *Model) Validate() error {
 		return fmt.Errorf("kallax: model %s has no table", m.Name)
 	}
 
+	if versions := versionFields(m.Fields); len(versions) > 1 {
+		return fmt.Errorf("kallax: found more than one version field in model %s: %s and %s", m.Name, versions[0].Name, versions[1].Name)
+	} else if len(versions) == 1 && !isValidVersion(versions[0]) {
+		return fmt.Errorf("kallax: version field %q of model %q does not have an integer type (%s)", versions[0].Name, m.Name, versions[0].Type)
+	}
+
+	if fields := softDeleteFields(m.Fields); len(fields) > 1 {
+		return fmt.Errorf("kallax: found more than one soft delete field in model %s: %s and %s", m.Name, fields[0].Name, fields[1].Name)
+	} else if len(fields) == 1 && !isValidSoftDelete(fields[0]) {
+		return fmt.Errorf("kallax: soft delete field %q of model %q is not a pointer to time.Time (%s)", fields[0].Name, m.Name, fields[0].Type)
+	}
+
 	return nil
 }
 
@@ -435,6 +447,48 @@ func (m *Model) SetFields(fields []*Field) error {
 	return nil
 }
 
+// Version returns the field used as the version of the model for optimistic
+// locking, or nil if the model has no version field.
+func (m *Model) Version() *Field {
+	if versions := versionFields(m.Fields); len(versions) > 0 {
+		return versions[0]
+	}
+	return nil
+}
+
+// SoftDelete returns the field used to store the time in which the model was
+// deleted, or nil if the model has no soft delete.
+func (m *Model) SoftDelete() *Field {
+	if fields := softDeleteFields(m.Fields); len(fields) > 0 {
+		return fields[0]
+	}
+	return nil
+}
+
+func softDeleteFields(fields []*Field) []*Field {
+	var result []*Field
+	for _, f := range fields {
+		if f.Inline() {
+			result = append(result, softDeleteFields(f.Fields)...)
+		} else if f.IsSoftDelete() {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func versionFields(fields []*Field) []*Field {
+	var result []*Field
+	for _, f := range fields {
+		if f.Inline() {
+			result = append(result, versionFields(f.Fields)...)
+		} else if f.IsVersion() {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
 // Relationships returns the fields of a model that are relationships.
 func (m *Model) Relationships() []*Field {
 	return relationshipsOnFields(m.Fields)
@@ -680,6 +734,35 @@ func (f *Field) Inline() bool {
 	return false
 }
 
+// IsVersion reports whether the field is the version of the model, used for
+// optimistic locking. A version field is the one having a struct tag `kallax`
+// containing `,version`.
+func (f *Field) IsVersion() bool {
+	tag := f.Tag.Get("kallax")
+	for _, p := range strings.Split(tag, ",")[1:] {
+		if p == "version" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSoftDelete reports whether the field stores the time in which the model
+// was deleted, which makes the model soft deletable. A soft delete field is
+// the one having a struct tag `kallax` containing `,softdelete`, like the
+// one in kallax.SoftDelete.
+func (f *Field) IsSoftDelete() bool {
+	tag := f.Tag.Get("kallax")
+	for _, p := range strings.Split(tag, ",")[1:] {
+		if p == "softdelete" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // JSONName returns the name of the field or its JSON name specified in the
 // JSON struct tag.
 func (f *Field) JSONName() string {
@@ -812,6 +895,24 @@ func isValidIdentifier(f *Field) bool {
 	return ok
 }
 
+func isValidVersion(f *Field) bool {
+	if f.Node == nil || f.IsPrimaryKey() {
+		return false
+	}
+
+	basic, ok := f.Node.Type().Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+func isValidSoftDelete(f *Field) bool {
+	if f.Node == nil {
+		return false
+	}
+
+	ptr, ok := f.Node.Type().(*types.Pointer)
+	return ok && typeName(ptr.Elem()) == "time.Time"
+}
+
 func arrayLen(f *Field) int {
 	if f.Kind != Array {
 		return 0
@@ -75,6 +75,40 @@ func (s *FieldSuite) TestIsAutoIncrement() {
 	}
 }
 
+func (s *FieldSuite) TestIsVersion() {
+	cases := []struct {
+		tag string
+		ok  bool
+	}{
+		{"", false},
+		{`kallax:"version"`, false},
+		{`kallax:",inline"`, false},
+		{`kallax:",version"`, true},
+		{`kallax:"foo,version"`, true},
+	}
+
+	for _, c := range cases {
+		s.Equal(c.ok, withTag(mkField("", ""), c.tag).IsVersion(), "field with tag: %s", c.tag)
+	}
+}
+
+func (s *FieldSuite) TestIsSoftDelete() {
+	cases := []struct {
+		tag string
+		ok  bool
+	}{
+		{"", false},
+		{`kallax:"softdelete"`, false},
+		{`kallax:",version"`, false},
+		{`kallax:",softdelete"`, true},
+		{`kallax:"deleted_at,softdelete"`, true},
+	}
+
+	for _, c := range cases {
+		s.Equal(c.ok, withTag(mkField("", ""), c.tag).IsSoftDelete(), "field with tag: %s", c.tag)
+	}
+}
+
 func (s *FieldSuite) TestColumnName() {
 	cases := []struct {
 		tag      string
@@ -345,6 +379,31 @@ func (s *ModelSuite) TestModelValidate() {
 	require.Error(m.Validate(), "should return error")
 }
 
+func (s *ModelSuite) TestModelValidate_Version() {
+	require := s.Require()
+
+	version := *s.model.ID
+	version.Name = "Version"
+	version.Tag = `kallax:",version"`
+	m := &Model{Name: "Foo", Table: "foo", ID: s.model.ID}
+	m.Fields = []*Field{s.model.ID, &version}
+	require.NoError(m.Validate(), "should not return error")
+	require.Equal(&version, m.Version())
+
+	other := version
+	other.Name = "Other"
+	m.Fields = []*Field{s.model.ID, &version, inline(mkField("Nested", "", &other))}
+	require.Error(m.Validate(), "should return error with two versions")
+
+	username := *s.model.Fields[2]
+	username.Tag = `kallax:",version"`
+	m.Fields = []*Field{s.model.ID, &username}
+	require.Error(m.Validate(), "should return error with a non integer version")
+
+	m.Fields = []*Field{s.model.ID}
+	require.Nil(m.Version())
+}
+
 func TestFieldForeignKey(t *testing.T) {
 	r := require.New(t)
 	m := &Model{Name: "Foo", Table: "bar", Type: "foo.Foo"}
@@ -0,0 +1,424 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Interceptor is a function that intercepts the execution of the SQL
+// statements run by a store. It receives the statement and the next handler
+// of the chain, which has to be called to actually execute it. Interceptors
+// can inspect the statement and its result, or even modify the statement
+// before calling the next handler.
+type Interceptor func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult
+
+// StatementHandler is a function that executes a SQL statement.
+type StatementHandler func(ctx context.Context, stmt *Statement) *StatementResult
+
+// StatementKind is the kind of a SQL statement, that is, the method used
+// to run it.
+type StatementKind string
+
+const (
+	// ExecStatement is a statement run with Exec.
+	ExecStatement StatementKind = "exec"
+	// QueryStatement is a statement run with Query.
+	QueryStatement StatementKind = "query"
+	// QueryRowStatement is a statement run with QueryRow.
+	QueryRowStatement StatementKind = "query_row"
+	// PrepareStatement is a statement run with Prepare. Stores prepare the
+	// statements they run the first time they run them, so the statements
+	// of this kind are intercepted while the statement they prepare is
+	// intercepted, before it is run.
+	PrepareStatement StatementKind = "prepare"
+)
+
+// Operation is the store operation a SQL statement is run for.
+type Operation string
+
+const (
+	// UnknownOperation is used for statements that are not run by any store
+	// operation.
+	UnknownOperation Operation = ""
+	// InsertOperation is used for the statements run to insert records.
+	InsertOperation Operation = "insert"
+	// UpsertOperation is used for the statements run to upsert records.
+	UpsertOperation Operation = "upsert"
+	// UpdateOperation is used for the statements run to update records.
+	UpdateOperation Operation = "update"
+	// DeleteOperation is used for the statements run to delete records.
+	DeleteOperation Operation = "delete"
+	// FindOperation is used for the statements run to find records.
+	FindOperation Operation = "find"
+	// CountOperation is used for the statements run to count records.
+	CountOperation Operation = "count"
+	// AggregateOperation is used for the statements run to aggregate
+	// records.
+	AggregateOperation Operation = "aggregate"
+	// CopyOperation is used for the statements run to copy records with
+	// COPY.
+	CopyOperation Operation = "copy"
+	// RawOperation is used for the raw statements.
+	RawOperation Operation = "raw"
+	// TransactionOperation is used for the statements run to manage
+	// transactions.
+	TransactionOperation Operation = "transaction"
+)
+
+// Statement is a SQL statement run by a store.
+type Statement struct {
+	// Kind is the kind of the statement.
+	Kind StatementKind
+	// Operation is the store operation the statement is run for.
+	Operation Operation
+	// Table is the table of the operation, if any.
+	Table string
+	// SQL is the SQL query of the statement.
+	SQL string
+	// Args are the arguments of the query.
+	Args []interface{}
+
+	rows *rowsHooks
+	// native reports whether the rows of the query can be read natively by
+	// the driver, instead of as *sql.Rows.
+	native bool
+	// copySource is the source of the rows of a COPY FROM statement.
+	copySource copySource
+	// copyWriter is the writer of the rows of a COPY TO statement.
+	copyWriter io.Writer
+	// preparer is the database or transaction a Prepare statement is
+	// prepared in, if it is prepared by the statement cache of a store.
+	preparer squirrel.PreparerContext
+}
+
+// OnRowsClosed registers a function to be called with the number of rows
+// read and the error found reading them, if any, once the rows returned by
+// the statement are closed. It returns false, and the function will never be
+// called, if the statement is not a query or its rows are not read by the
+// store through a result set.
+func (s *Statement) OnRowsClosed(fn func(rows int64, err error)) bool {
+	if s.rows == nil {
+		return false
+	}
+
+	s.rows.fns = append(s.rows.fns, fn)
+	return true
+}
+
+// StatementResult is the result of running a SQL statement. Only the field
+// of the result corresponding to the kind of the statement is set.
+type StatementResult struct {
+	// Result is the result of an Exec statement.
+	Result sql.Result
+	// Rows are the rows returned by a Query statement. They are nil if the
+	// rows are read natively by the driver, as in the stores created with
+	// NewStoreWithNativeDriver.
+	Rows *sql.Rows
+	// Row is the row returned by a QueryRow statement.
+	Row squirrel.RowScanner
+	// Stmt is the prepared statement of a Prepare statement.
+	Stmt *sql.Stmt
+	// Duration is the time it took to run the statement. In the case of
+	// queries, it does not include the time spent reading the rows.
+	Duration time.Duration
+	// Err is the error returned running the statement, if any.
+	Err error
+
+	// rows are the rows returned by a Query statement whose rows can be read
+	// natively.
+	rows resultRows
+}
+
+type operationKey struct{}
+
+type operation struct {
+	op    Operation
+	table string
+}
+
+// withOperation returns a context carrying the given operation and table,
+// which will be set in the statements run with it.
+func withOperation(ctx context.Context, op Operation, table string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation{op, table})
+}
+
+func operationFromContext(ctx context.Context) operation {
+	op, _ := ctx.Value(operationKey{}).(operation)
+	return op
+}
+
+type rowsHooksKey struct{}
+
+// rowsHooks are the functions to call when the rows returned by a query are
+// closed.
+type rowsHooks struct {
+	// claimed reports whether the hooks belong to a query already.
+	claimed bool
+	fns     []func(int64, error)
+}
+
+// withRowsHooks returns a context whose first query statement will have its
+// hooks registered in the returned rowsHooks, which must then be called by
+// the result set reading the rows of the query.
+func withRowsHooks(ctx context.Context) (context.Context, *rowsHooks) {
+	hooks := new(rowsHooks)
+	return context.WithValue(ctx, rowsHooksKey{}, hooks), hooks
+}
+
+// closed calls all the hooks with the given number of rows and error. Hooks
+// are only called once, so it can be safely called more than once.
+func (h *rowsHooks) closed(rows int64, err error) {
+	if h == nil {
+		return
+	}
+
+	fns := h.fns
+	h.fns = nil
+	for _, fn := range fns {
+		fn(rows, err)
+	}
+}
+
+// interceptorProxy is a database proxy that runs all the statements through
+// a chain of interceptors.
+type interceptorProxy struct {
+	interceptors []Interceptor
+	proxy        dbProxy
+}
+
+func (p *interceptorProxy) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return p.ExecContext(context.Background(), query, args...)
+}
+
+func (p *interceptorProxy) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return p.QueryContext(context.Background(), query, args...)
+}
+
+func (p *interceptorProxy) QueryRow(query string, args ...interface{}) squirrel.RowScanner {
+	return p.QueryRowContext(context.Background(), query, args...)
+}
+
+func (p *interceptorProxy) Prepare(query string) (*sql.Stmt, error) {
+	return p.PrepareContext(context.Background(), query)
+}
+
+func (p *interceptorProxy) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	res := p.run(ctx, ExecStatement, query, args)
+	return res.Result, res.Err
+}
+
+func (p *interceptorProxy) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	res := p.run(ctx, QueryStatement, query, args)
+	return res.Rows, res.Err
+}
+
+func (p *interceptorProxy) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	res := p.run(ctx, QueryRowStatement, query, args)
+	if res.Err != nil || res.Row == nil {
+		return &row{err: res.Err}
+	}
+	return res.Row
+}
+
+func (p *interceptorProxy) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	res := p.run(ctx, PrepareStatement, query, nil)
+	return res.Stmt, res.Err
+}
+
+func (p *interceptorProxy) queryRows(ctx context.Context, query string, args []interface{}) (resultRows, error) {
+	stmt := newStatement(ctx, QueryStatement, query, args)
+	stmt.native = true
+	res := p.handler(0)(ctx, stmt)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	// interceptors may have replaced the rows returned by the database
+	if res.Rows != nil {
+		return res.Rows, nil
+	}
+	return res.rows, nil
+}
+
+func (p *interceptorProxy) copyFrom(ctx context.Context, query string, src copySource) (int64, error) {
+	stmt := newStatement(ctx, ExecStatement, query, nil)
+	stmt.copySource = src
+	return p.runCopy(ctx, stmt)
+}
+
+func (p *interceptorProxy) copyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	stmt := newStatement(ctx, ExecStatement, query, nil)
+	stmt.copyWriter = w
+	return p.runCopy(ctx, stmt)
+}
+
+// runCopy runs the given COPY statement through the chain of interceptors
+// and returns the number of rows copied.
+func (p *interceptorProxy) runCopy(ctx context.Context, stmt *Statement) (int64, error) {
+	res := p.handler(0)(ctx, stmt)
+	if res.Err != nil {
+		return 0, res.Err
+	}
+
+	if res.Result == nil {
+		return 0, nil
+	}
+	return res.Result.RowsAffected()
+}
+
+func (p *interceptorProxy) run(ctx context.Context, kind StatementKind, query string, args []interface{}) *StatementResult {
+	return p.handler(0)(ctx, newStatement(ctx, kind, query, args))
+}
+
+// newStatement returns the statement of the given query run with the given
+// context.
+func newStatement(ctx context.Context, kind StatementKind, query string, args []interface{}) *Statement {
+	op := operationFromContext(ctx)
+	stmt := &Statement{
+		Kind:      kind,
+		Operation: op.op,
+		Table:     op.table,
+		SQL:       query,
+		Args:      args,
+	}
+
+	switch kind {
+	case QueryStatement:
+		hooks, ok := ctx.Value(rowsHooksKey{}).(*rowsHooks)
+		if ok && !hooks.claimed {
+			hooks.claimed = true
+			stmt.rows = hooks
+		}
+	case QueryRowStatement:
+		stmt.rows = new(rowsHooks)
+	}
+
+	return stmt
+}
+
+// handler returns the handler that runs the chain of interceptors starting
+// at the given one.
+func (p *interceptorProxy) handler(i int) StatementHandler {
+	if i >= len(p.interceptors) {
+		return p.execute
+	}
+
+	return func(ctx context.Context, stmt *Statement) *StatementResult {
+		return p.interceptors[i](ctx, stmt, p.handler(i+1))
+	}
+}
+
+// execute runs the statement in the underlying proxy.
+func (p *interceptorProxy) execute(ctx context.Context, stmt *Statement) *StatementResult {
+	if stmt.Kind != PrepareStatement {
+		// the statement cache prepares the statement through the chain too
+		ctx = context.WithValue(ctx, interceptorProxyKey{}, p)
+	}
+
+	var res StatementResult
+	start := time.Now()
+	switch stmt.Kind {
+	case ExecStatement:
+		var n int64
+		switch {
+		case stmt.copySource != nil:
+			if n, res.Err = copyFrom(ctx, p.proxy, stmt.SQL, stmt.copySource); res.Err == nil {
+				res.Result = driver.RowsAffected(n)
+			}
+		case stmt.copyWriter != nil:
+			if n, res.Err = copyTo(ctx, p.proxy, stmt.SQL, stmt.copyWriter); res.Err == nil {
+				res.Result = driver.RowsAffected(n)
+			}
+		default:
+			res.Result, res.Err = p.proxy.ExecContext(ctx, stmt.SQL, stmt.Args...)
+		}
+	case QueryStatement:
+		if stmt.native {
+			res.rows, res.Err = queryRows(ctx, p.proxy, stmt.SQL, stmt.Args)
+			res.Rows, _ = res.rows.(*sql.Rows)
+		} else {
+			res.Rows, res.Err = p.proxy.QueryContext(ctx, stmt.SQL, stmt.Args...)
+		}
+	case QueryRowStatement:
+		// the query is run eagerly, as QueryRow does, so the error can be
+		// known now instead of when the row is scanned
+		var rows *sql.Rows
+		rows, res.Err = p.proxy.QueryContext(ctx, stmt.SQL, stmt.Args...)
+		if res.Err == nil {
+			res.Row = &row{rows: rows, hooks: stmt.rows}
+		}
+	case PrepareStatement:
+		if stmt.preparer != nil {
+			res.Stmt, res.Err = stmt.preparer.PrepareContext(ctx, stmt.SQL)
+		} else {
+			res.Stmt, res.Err = p.proxy.PrepareContext(ctx, stmt.SQL)
+		}
+	}
+	res.Duration = time.Since(start)
+	return &res
+}
+
+type interceptorProxyKey struct{}
+
+// interceptedPreparer is the preparer of the statement caches of the
+// stores, which prepares the statements in the wrapped database or
+// transaction through the chain of interceptors of the statement being run,
+// if any, so the prepares of the cache are intercepted as well.
+type interceptedPreparer struct {
+	squirrel.PreparerContext
+}
+
+func (p interceptedPreparer) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	proxy, ok := ctx.Value(interceptorProxyKey{}).(*interceptorProxy)
+	if !ok {
+		return p.PreparerContext.PrepareContext(ctx, query)
+	}
+
+	stmt := newStatement(ctx, PrepareStatement, query, nil)
+	stmt.preparer = p.PreparerContext
+	res := proxy.handler(0)(ctx, stmt)
+	return res.Stmt, res.Err
+}
+
+// row is a squirrel.RowScanner that scans the first of the given rows, or
+// returns the given error. Once scanned, the hooks are called with the
+// number of rows read.
+type row struct {
+	rows  *sql.Rows
+	err   error
+	hooks *rowsHooks
+}
+
+func (r *row) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.rows == nil {
+		r.hooks.closed(0, nil)
+		return sql.ErrNoRows
+	}
+
+	defer r.rows.Close()
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		r.hooks.closed(0, err)
+		if err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+
+	r.hooks.closed(1, nil)
+
+	if err := r.rows.Scan(dest...); err != nil {
+		return err
+	}
+
+	return r.rows.Close()
+}
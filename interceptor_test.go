@@ -0,0 +1,233 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProxy struct {
+	queries []string
+	result  sql.Result
+	err     error
+	// row is the row returned by QueryRow, if any.
+	row squirrel.RowScanner
+}
+
+func (p *fakeProxy) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return p.ExecContext(context.Background(), query, args...)
+}
+
+func (p *fakeProxy) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return p.QueryContext(context.Background(), query, args...)
+}
+
+func (p *fakeProxy) QueryRow(query string, args ...interface{}) squirrel.RowScanner {
+	return p.QueryRowContext(context.Background(), query, args...)
+}
+
+func (p *fakeProxy) Prepare(query string) (*sql.Stmt, error) {
+	return p.PrepareContext(context.Background(), query)
+}
+
+func (p *fakeProxy) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.queries = append(p.queries, query)
+	return p.result, p.err
+}
+
+func (p *fakeProxy) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	p.queries = append(p.queries, query)
+	return nil, p.err
+}
+
+func (p *fakeProxy) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	p.queries = append(p.queries, query)
+	if p.row != nil {
+		return p.row
+	}
+	return &row{err: p.err}
+}
+
+func (p *fakeProxy) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	p.queries = append(p.queries, query)
+	return nil, p.err
+}
+
+func TestInterceptorProxy_Chain(t *testing.T) {
+	require := require.New(t)
+
+	var calls []string
+	interceptor := func(name string) Interceptor {
+		return func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+			calls = append(calls, name)
+			stmt.SQL += " " + name
+			return next(ctx, stmt)
+		}
+	}
+
+	fake := &fakeProxy{}
+	proxy := &interceptorProxy{
+		[]Interceptor{interceptor("a"), interceptor("b")},
+		fake,
+	}
+
+	_, err := proxy.Exec("SELECT 1")
+	require.NoError(err)
+	require.Equal([]string{"a", "b"}, calls)
+	require.Equal([]string{"SELECT 1 a b"}, fake.queries)
+}
+
+func TestInterceptorProxy_Statement(t *testing.T) {
+	require := require.New(t)
+
+	var stmt Statement
+	var res *StatementResult
+	fake := &fakeProxy{err: errors.New("foo")}
+	proxy := &interceptorProxy{
+		[]Interceptor{func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+			res = next(ctx, s)
+			stmt = *s
+			return res
+		}},
+		fake,
+	}
+
+	ctx := withOperation(context.Background(), FindOperation, "foo")
+	err := proxy.QueryRowContext(ctx, "SELECT $1", 1).Scan()
+	require.EqualError(err, "foo")
+	require.NotNil(stmt.rows)
+	stmt.rows = nil
+	require.Equal(Statement{
+		Kind:      QueryRowStatement,
+		Operation: FindOperation,
+		Table:     "foo",
+		SQL:       "SELECT $1",
+		Args:      []interface{}{1},
+	}, stmt)
+	require.EqualError(res.Err, "foo")
+
+	_, err = proxy.Prepare("SELECT 1")
+	require.EqualError(err, "foo")
+	require.Equal(PrepareStatement, stmt.Kind)
+	require.Equal(UnknownOperation, stmt.Operation)
+}
+
+// fakeRow is a row that is scanned with a function.
+type fakeRow func(...interface{}) error
+
+func (f fakeRow) Scan(dest ...interface{}) error { return f(dest...) }
+
+type fakeRowsProxy struct {
+	fakeProxy
+	rows   resultRows
+	copied [][]interface{}
+}
+
+func (p *fakeRowsProxy) queryRows(ctx context.Context, query string, args []interface{}) (resultRows, error) {
+	p.queries = append(p.queries, query)
+	return p.rows, p.err
+}
+
+type fakeRows struct{}
+
+func (fakeRows) Next() bool                 { return false }
+func (fakeRows) Scan(...interface{}) error  { return nil }
+func (fakeRows) Columns() ([]string, error) { return nil, nil }
+func (fakeRows) Err() error                 { return nil }
+func (fakeRows) Close() error               { return nil }
+
+func TestInterceptorProxy_QueryRows(t *testing.T) {
+	require := require.New(t)
+
+	var stmt Statement
+	var res *StatementResult
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	proxy := &interceptorProxy{
+		[]Interceptor{func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+			res = next(ctx, s)
+			stmt = *s
+			return res
+		}},
+		&debugProxy{func(string, ...interface{}) {}, fake},
+	}
+
+	rows, err := queryRows(context.Background(), proxy, "SELECT 1", nil)
+	require.NoError(err)
+	require.Equal(fakeRows{}, rows)
+	require.Equal(QueryStatement, stmt.Kind)
+	require.True(stmt.native)
+	require.Nil(res.Rows)
+	require.Equal([]string{"SELECT 1"}, fake.queries)
+
+	fake.err = errors.New("foo")
+	rows, err = queryRows(context.Background(), proxy, "SELECT 2", nil)
+	require.EqualError(err, "foo")
+	require.Nil(rows)
+}
+
+func TestInterceptorProxy_Skip(t *testing.T) {
+	fake := &fakeProxy{}
+	proxy := &interceptorProxy{
+		[]Interceptor{func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+			return &StatementResult{}
+		}},
+		fake,
+	}
+
+	require.Equal(t, sql.ErrNoRows, proxy.QueryRow("SELECT 1").Scan())
+	require.Len(t, fake.queries, 0)
+}
+
+func TestStoreWithInterceptors(t *testing.T) {
+	require := require.New(t)
+
+	noop := func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+		return next(ctx, s)
+	}
+
+	store := NewStore(nil)
+	s1 := store.WithInterceptors(noop)
+	s2 := s1.WithInterceptors(noop, noop)
+	require.Len(store.interceptors, 0)
+	require.Len(s1.interceptors, 1)
+	require.Len(s2.interceptors, 3)
+
+	proxy, ok := s2.proxy.(*interceptorProxy)
+	require.True(ok)
+	require.Len(proxy.interceptors, 3)
+
+	debug, ok := s2.Debug().proxy.(*interceptorProxy)
+	require.True(ok)
+	require.IsType(&debugProxy{}, debug.proxy)
+}
+
+func TestStoreWithInterceptors_Prepare(t *testing.T) {
+	require := require.New(t)
+
+	var stmts []Statement
+	record := func(ctx context.Context, s *Statement, next StatementHandler) *StatementResult {
+		res := next(ctx, s)
+		stmts = append(stmts, *s)
+		return res
+	}
+
+	// the statements are prepared by the statement cache of the store
+	db := &fakeProxy{err: errors.New("foo")}
+	store := (&Store{runner: newStmtCacher(db)}).build()
+	require.EqualError(store.Insert(ModelSchema, newModel("Joe", "", 1)), "foo")
+	require.Len(db.queries, 1)
+
+	store = store.WithInterceptors(record)
+	require.EqualError(store.Insert(ModelSchema, newModel("Joe", "", 1)), "foo")
+	require.Len(db.queries, 2)
+	require.Len(stmts, 2)
+	require.Equal(PrepareStatement, stmts[0].Kind)
+	require.Equal(InsertOperation, stmts[0].Operation)
+	require.Equal(db.queries[1], stmts[0].SQL)
+	require.Equal(QueryRowStatement, stmts[1].Kind)
+	require.Equal(db.queries[1], stmts[1].SQL)
+}
@@ -0,0 +1,103 @@
+package kallax
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrLockOutsideTransaction is returned when a query locking the rows it
+	// selects is run in a store that is not in a transaction, since the locks
+	// would be released as soon as the query ends.
+	ErrLockOutsideTransaction = errors.New("kallax: queries locking rows must be run in a transaction")
+	// ErrLockNotSupported is returned when a query locking the rows it selects
+	// is run in a store whose dialect does not support it.
+	ErrLockNotSupported = errors.New("kallax: row locking is not supported by the dialect")
+)
+
+// rowLock is the locking clause of a query, which locks the rows selected by
+// the query with the given strength, waiting policy and only in the tables
+// of the given schemas, if any.
+type rowLock struct {
+	strength string
+	wait     string
+	of       []Schema
+}
+
+func (l rowLock) isSet() bool {
+	return l.strength != "" || l.wait != "" || len(l.of) > 0
+}
+
+func (l rowLock) copy() rowLock {
+	l.of = l.of[:len(l.of):len(l.of)]
+	return l
+}
+
+// toSql returns the SQL of the locking clause, which locks the rows for
+// update if no strength is set. from are the schemas in the FROM clause of
+// the query, with the aliases they are selected with, which the schemas
+// given to Of are resolved to. An error is returned if any of them is not in
+// the FROM clause.
+func (l rowLock) toSql(from []Schema) (string, error) {
+	strength := l.strength
+	if strength == "" {
+		strength = "UPDATE"
+	}
+
+	sql := "FOR " + strength
+	if len(l.of) > 0 {
+		var aliases []string
+		for _, schema := range l.of {
+			resolved := resolveLockAliases(schema, from)
+			if len(resolved) == 0 {
+				return "", fmt.Errorf("kallax: can not lock the rows of table %s, as it is not selected by the query", schema.Table())
+			}
+			aliases = append(aliases, resolved...)
+		}
+		sql += " OF " + strings.Join(aliases, ", ")
+	}
+
+	if l.wait != "" {
+		sql += " " + l.wait
+	}
+	return sql, nil
+}
+
+// resolveLockAliases returns the alias of the given schema in the FROM
+// clause if it is there with the same alias, or the aliases of all the
+// schemas of its table otherwise, as relationships are joined with the
+// alias of their field.
+func resolveLockAliases(schema Schema, from []Schema) []string {
+	for _, s := range from {
+		if s.Alias() == schema.Alias() {
+			return []string{s.Alias()}
+		}
+	}
+
+	var aliases []string
+	for _, s := range from {
+		if s.Table() == schema.Table() {
+			aliases = append(aliases, s.Alias())
+		}
+	}
+	return aliases
+}
+
+// checkLock returns an error if the rows selected by the given query can not
+// be locked by the store.
+func (s *Store) checkLock(q Query) error {
+	if !q.locksRows() {
+		return nil
+	}
+
+	if !s.dialect.supportsLocking() {
+		return ErrLockNotSupported
+	}
+
+	// stores in transactions have no database
+	if s.db != nil {
+		return ErrLockOutsideTransaction
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package kallax
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowLock(t *testing.T) {
+	cases := []struct {
+		lock rowLock
+		sql  string
+	}{
+		{rowLock{}, "FOR UPDATE"},
+		{rowLock{strength: "SHARE"}, "FOR SHARE"},
+		{rowLock{wait: "SKIP LOCKED"}, "FOR UPDATE SKIP LOCKED"},
+		{rowLock{strength: "SHARE", wait: "NOWAIT"}, "FOR SHARE NOWAIT"},
+		{rowLock{of: []Schema{ModelSchema, RelSchema}}, "FOR UPDATE OF __model, __rel_rel"},
+		{rowLock{of: []Schema{RelSchema.WithAlias("rel")}}, "FOR UPDATE OF __rel_rel"},
+	}
+
+	from := []Schema{ModelSchema, RelSchema.WithAlias("rel")}
+	for _, c := range cases {
+		sql, err := c.lock.toSql(from)
+		require.NoError(t, err)
+		require.Equal(t, c.sql, sql)
+	}
+
+	_, err := rowLock{of: []Schema{RelSchema}}.toSql([]Schema{ModelSchema})
+	require.Error(t, err)
+
+	require.False(t, rowLock{}.isSet())
+	require.True(t, rowLock{wait: "NOWAIT"}.isSet())
+}
+
+func TestStoreCheckLock(t *testing.T) {
+	require := require.New(t)
+	q := NewBaseQuery(ModelSchema)
+	q.ForUpdate()
+
+	store := (&Store{db: new(sql.DB), runner: &fakeRowsProxy{rows: fakeRows{}}}).build()
+	_, err := store.Find(q)
+	require.Equal(ErrLockOutsideTransaction, err)
+
+	var result []struct{ ID int64 }
+	require.Equal(ErrLockOutsideTransaction, store.FindInto(q, &result))
+
+	store = (&Store{runner: &fakeRowsProxy{rows: fakeRows{}}, dialect: SQLite}).build()
+	_, err = store.Find(q)
+	require.Equal(ErrLockNotSupported, err)
+
+	fake := &fakeRowsProxy{rows: fakeRows{}}
+	store = (&Store{runner: fake}).build()
+	_, err = store.Find(q)
+	require.NoError(err)
+	_, err = store.Find(NewBaseQuery(ModelSchema))
+	require.NoError(err)
+
+	_, err = store.Count(q)
+	require.Equal(sql.ErrNoRows, err)
+	require.Len(fake.queries, 3)
+	require.Contains(fake.queries[0], "FOR UPDATE")
+	require.Equal("SELECT COUNT(id) FROM model __model", fake.queries[2])
+}
@@ -0,0 +1,142 @@
+package kallax
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsSink is a sink for the metrics of a store, so they can be exposed
+// with any metrics library.
+type MetricsSink interface {
+	// IncCounter increments the counter with the given name and labels by
+	// the given delta.
+	IncCounter(name string, labels map[string]string, delta int64)
+	// ObserveHistogram records a value in the histogram with the given name
+	// and labels.
+	ObserveHistogram(name string, labels map[string]string, value float64)
+	// SetGauge sets the value of the gauge with the given name and labels.
+	SetGauge(name string, labels map[string]string, value float64)
+}
+
+const (
+	// MetricQueries is the counter of SQL statements run, labeled by
+	// "table" and "operation".
+	MetricQueries = "kallax_queries_total"
+	// MetricQueryErrors is the counter of SQL statements that failed,
+	// labeled by "table", "operation" and "code", which is the SQLSTATE
+	// code of the error or "unknown" if it was not returned by the database.
+	MetricQueryErrors = "kallax_query_errors_total"
+	// MetricQueryDuration is the histogram of the seconds it took to run the
+	// SQL statements, labeled by "table" and "operation".
+	MetricQueryDuration = "kallax_query_duration_seconds"
+	// MetricRowsScanned is the counter of rows scanned into records by the
+	// result sets, labeled by "table".
+	MetricRowsScanned = "kallax_rows_scanned_total"
+	// MetricBatches is the counter of batches loaded by the batching result
+	// sets, labeled by "table".
+	MetricBatches = "kallax_batches_total"
+	// MetricTransactions is the counter of finished transactions, labeled by
+	// "result", which is either "commit" or "rollback".
+	MetricTransactions = "kallax_transactions_total"
+	// MetricOpenConnections is the gauge of open connections to the database.
+	MetricOpenConnections = "kallax_db_open_connections"
+)
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *Store) WithMetrics(sink MetricsSink) *Store {
+	store := s.copy()
+	store.metrics = sink
+	return store.build()
+}
+
+// ReportDBStats reports the stats of the database of the store to its
+// metrics sink every interval until the given context is done. It is meant
+// to be run in its own goroutine, and it returns immediately if the store has
+// no metrics sink or database.
+func (s *Store) ReportDBStats(ctx context.Context, interval time.Duration) {
+	if s.metrics == nil || s.db == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		stats := s.db.Stats()
+		s.metrics.SetGauge(MetricOpenConnections, nil, float64(stats.OpenConnections))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// metricsInterceptor returns an interceptor that reports the metrics of all
+// the statements it intercepts to the given sink.
+func metricsInterceptor(sink MetricsSink) Interceptor {
+	return func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+		// the prepared statements are counted when they are run
+		if stmt.Kind == PrepareStatement {
+			return next(ctx, stmt)
+		}
+
+		res := next(ctx, stmt)
+		labels := map[string]string{
+			"table":     stmt.Table,
+			"operation": string(stmt.Operation),
+		}
+
+		sink.IncCounter(MetricQueries, labels, 1)
+		sink.ObserveHistogram(MetricQueryDuration, labels, res.Duration.Seconds())
+		if res.Err != nil {
+			sink.IncCounter(MetricQueryErrors, map[string]string{
+				"table":     stmt.Table,
+				"operation": string(stmt.Operation),
+				"code":      errorCode(res.Err),
+			}, 1)
+		}
+
+		return res
+	}
+}
+
+// errorCode returns the SQLSTATE code of the given error, or "unknown" if
+// it was not returned by the database.
+func errorCode(err error) string {
+	if code, ok := sqlState(err); ok {
+		return code
+	}
+	return "unknown"
+}
+
+// reportRowsScanned reports the rows scanned by the given result set once it
+// is closed.
+func reportRowsScanned(sink MetricsSink, table string, rs *BaseResultSet) {
+	if sink == nil || rs.hooks == nil {
+		return
+	}
+
+	rs.hooks.fns = append(rs.hooks.fns, func(int64, error) {
+		if rs.scanned > 0 {
+			sink.IncCounter(MetricRowsScanned, map[string]string{"table": table}, rs.scanned)
+		}
+	})
+}
+
+func reportBatch(sink MetricsSink, table string) {
+	if sink == nil {
+		return
+	}
+
+	sink.IncCounter(MetricBatches, map[string]string{"table": table}, 1)
+}
+
+func reportTransaction(sink MetricsSink, result string) {
+	if sink == nil {
+		return
+	}
+
+	sink.IncCounter(MetricTransactions, map[string]string{"result": result}, 1)
+}
@@ -0,0 +1,113 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	counters   map[string]int64
+	histograms map[string][]float64
+	gauges     map[string]float64
+}
+
+func newFakeSink() *fakeSink {
+	return &fakeSink{
+		counters:   make(map[string]int64),
+		histograms: make(map[string][]float64),
+		gauges:     make(map[string]float64),
+	}
+}
+
+func metricKey(name string, labels map[string]string) string {
+	key := name
+	for _, l := range []string{"table", "operation", "code", "result"} {
+		if v, ok := labels[l]; ok {
+			key += "," + l + "=" + v
+		}
+	}
+	return key
+}
+
+func (s *fakeSink) IncCounter(name string, labels map[string]string, delta int64) {
+	s.counters[metricKey(name, labels)] += delta
+}
+
+func (s *fakeSink) ObserveHistogram(name string, labels map[string]string, value float64) {
+	key := metricKey(name, labels)
+	s.histograms[key] = append(s.histograms[key], value)
+}
+
+func (s *fakeSink) SetGauge(name string, labels map[string]string, value float64) {
+	s.gauges[metricKey(name, labels)] = value
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	require := require.New(t)
+
+	sink := newFakeSink()
+	fake := &fakeProxy{}
+	proxy := &interceptorProxy{[]Interceptor{metricsInterceptor(sink)}, fake}
+
+	ctx := withOperation(context.Background(), UpdateOperation, "model")
+	_, err := proxy.ExecContext(ctx, "UPDATE model SET name = $1", "foo")
+	require.NoError(err)
+
+	fake.err = &pq.Error{Code: "23505"}
+	_, err = proxy.ExecContext(ctx, "UPDATE model SET name = $1", "foo")
+	require.Error(err)
+
+	// prepares are not counted
+	_, err = proxy.PrepareContext(ctx, "UPDATE model SET name = $1")
+	require.Error(err)
+
+	require.Equal(map[string]int64{
+		"kallax_queries_total,table=model,operation=update":                 2,
+		"kallax_query_errors_total,table=model,operation=update,code=23505": 1,
+	}, sink.counters)
+	require.Len(sink.histograms["kallax_query_duration_seconds,table=model,operation=update"], 2)
+}
+
+func TestErrorCode(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("40001", errorCode(&pq.Error{Code: "40001"}))
+	require.Equal("40P01", errorCode(&txError{"foo", &pq.Error{Code: "40P01"}}))
+	require.Equal("unknown", errorCode(errors.New("foo")))
+}
+
+func TestStoreWithMetrics(t *testing.T) {
+	require := require.New(t)
+
+	sink := newFakeSink()
+	store := (&Store{runner: &fakeProxy{}}).build().WithMetrics(sink)
+	_, err := store.Count(NewBaseQuery(ModelSchema))
+	require.Equal(sql.ErrNoRows, err)
+	require.Equal(int64(1), sink.counters["kallax_queries_total,table=model,operation=count"])
+
+	store = (&Store{runner: &fakeProxy{}}).build()
+	store.ReportDBStats(context.Background(), 0)
+	require.Len(sink.gauges, 0)
+}
+
+func TestMetricsReportDBStats(t *testing.T) {
+	require := require.New(t)
+
+	db, err := sql.Open("postgres", "")
+	require.NoError(err)
+	defer db.Close()
+
+	sink := newFakeSink()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	NewStore(db).WithMetrics(sink).ReportDBStats(ctx, 1)
+
+	require.Equal(map[string]float64{
+		"kallax_db_open_connections": 0,
+	}, sink.gauges)
+}
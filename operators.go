@@ -138,7 +138,7 @@ func Like(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func Ilike(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "ILIKE", value}
+		return dialectOp(schema, opIlike, col, value)
 	}
 }
 
@@ -147,7 +147,7 @@ func Ilike(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func SimilarTo(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "SIMILAR TO", value}
+		return dialectOp(schema, opSimilarTo, col, value)
 	}
 }
 
@@ -156,7 +156,7 @@ func SimilarTo(col SchemaField, value string) Condition {
 // See https://www.postgresql.org/docs/9.6/static/functions-matching.html.
 func NotSimilarTo(col SchemaField, value string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "NOT SIMILAR TO", value}
+		return dialectOp(schema, opNotSimilarTo, col, value)
 	}
 }
 
@@ -201,7 +201,7 @@ func NotIn(col SchemaField, values ...interface{}) Condition {
 // array with the given elements.
 func ArrayEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "=", types.Slice(values)}
+		return dialectOp(schema, opArrayEq, col, types.Slice(values))
 	}
 }
 
@@ -209,7 +209,7 @@ func ArrayEq(col SchemaField, values ...interface{}) Condition {
 // an array with the given elements.
 func ArrayNotEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<>", types.Slice(values)}
+		return dialectOp(schema, opArrayNotEq, col, types.Slice(values))
 	}
 }
 
@@ -220,7 +220,7 @@ func ArrayNotEq(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayLt(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<", types.Slice(values)}
+		return dialectOp(schema, opArrayLt, col, types.Slice(values))
 	}
 }
 
@@ -231,7 +231,7 @@ func ArrayLt(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayGt(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), ">", types.Slice(values)}
+		return dialectOp(schema, opArrayGt, col, types.Slice(values))
 	}
 }
 
@@ -241,7 +241,7 @@ func ArrayGt(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayLtOrEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<=", types.Slice(values)}
+		return dialectOp(schema, opArrayLtOrEq, col, types.Slice(values))
 	}
 }
 
@@ -251,7 +251,7 @@ func ArrayLtOrEq(col SchemaField, values ...interface{}) Condition {
 // true.
 func ArrayGtOrEq(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), ">=", types.Slice(values)}
+		return dialectOp(schema, opArrayGtOrEq, col, types.Slice(values))
 	}
 }
 
@@ -259,7 +259,7 @@ func ArrayGtOrEq(col SchemaField, values ...interface{}) Condition {
 // given values.
 func ArrayContains(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "@>", types.Slice(values)}
+		return dialectOp(schema, opArrayContains, col, types.Slice(values))
 	}
 }
 
@@ -267,7 +267,7 @@ func ArrayContains(col SchemaField, values ...interface{}) Condition {
 // its elements present in the given values.
 func ArrayContainedBy(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<@", types.Slice(values)}
+		return dialectOp(schema, opArrayContainedBy, col, types.Slice(values))
 	}
 }
 
@@ -275,7 +275,7 @@ func ArrayContainedBy(col SchemaField, values ...interface{}) Condition {
 // in common with an array formed by the given values.
 func ArrayOverlap(col SchemaField, values ...interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "&&", types.Slice(values)}
+		return dialectOp(schema, opArrayOverlap, col, types.Slice(values))
 	}
 }
 
@@ -283,7 +283,7 @@ func ArrayOverlap(col SchemaField, values ...interface{}) Condition {
 // object.
 func JSONIsObject(col SchemaField) Condition {
 	return func(schema Schema) ToSqler {
-		return &colUnaryOp{col.QualifiedName(schema), " @> '{}'"}
+		return dialectOp(schema, opJSONIsObject, col)
 	}
 }
 
@@ -291,7 +291,7 @@ func JSONIsObject(col SchemaField) Condition {
 // array.
 func JSONIsArray(col SchemaField) Condition {
 	return func(schema Schema) ToSqler {
-		return &colUnaryOp{col.QualifiedName(schema), " @> '[]'"}
+		return dialectOp(schema, opJSONIsArray, col)
 	}
 }
 
@@ -299,7 +299,7 @@ func JSONIsArray(col SchemaField) Condition {
 // the given element converted to JSON.
 func JSONContains(col SchemaField, elem interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "@>", types.JSON(elem)}
+		return dialectOp(schema, opJSONContains, col, types.JSON(elem))
 	}
 }
 
@@ -313,9 +313,18 @@ func JSONContainsAny(col SchemaField, elems ...interface{}) Condition {
 	}
 	return func(schema Schema) ToSqler {
 		if len(elems) == 0 {
-			return &errOp{"can't check if json contains 0 elements"}
+			return &errOp{errors.New("can't check if json contains 0 elements")}
 		}
-		return &containsAny{col.QualifiedName(schema), elems}
+
+		if _, ok := dialectOf(schema).operator(opJSONContainsAny); ok {
+			return &containsAny{col.QualifiedName(schema), elems}
+		}
+
+		var conds = make([]Condition, len(elems))
+		for i, elem := range elems {
+			conds[i] = JSONContains(col, elem)
+		}
+		return Or(conds...)(schema)
 	}
 }
 
@@ -323,7 +332,7 @@ func JSONContainsAny(col SchemaField, elems ...interface{}) Condition {
 // contained by the given element converted to JSON.
 func JSONContainedBy(col SchemaField, elem interface{}) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "<@", types.JSON(elem)}
+		return dialectOp(schema, opJSONContainedBy, col, types.JSON(elem))
 	}
 }
 
@@ -331,7 +340,7 @@ func JSONContainedBy(col SchemaField, elem interface{}) Condition {
 // any of the given keys. Will also match elements if the column is an array.
 func JSONContainsAnyKey(col SchemaField, keys ...string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "??|", types.Slice(keys)}
+		return dialectOp(schema, opJSONContainsAnyKey, col, types.Slice(keys))
 	}
 }
 
@@ -340,7 +349,7 @@ func JSONContainsAnyKey(col SchemaField, keys ...string) Condition {
 // array.
 func JSONContainsAllKeys(col SchemaField, keys ...string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "??&", types.Slice(keys)}
+		return dialectOp(schema, opJSONContainsAllKey, col, types.Slice(keys))
 	}
 }
 
@@ -348,7 +357,7 @@ func JSONContainsAllKeys(col SchemaField, keys ...string) Condition {
 // the given POSIX regex. Match is case sensitive.
 func MatchRegexCase(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "~", driver.Value(pattern)}
+		return dialectOp(schema, opMatchRegexCase, col, driver.Value(pattern))
 	}
 }
 
@@ -356,7 +365,7 @@ func MatchRegexCase(col SchemaField, pattern string) Condition {
 // the given POSIX regex. Match is case insensitive.
 func MatchRegex(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "~*", driver.Value(pattern)}
+		return dialectOp(schema, opMatchRegex, col, driver.Value(pattern))
 	}
 }
 
@@ -364,7 +373,7 @@ func MatchRegex(col SchemaField, pattern string) Condition {
 // match the given POSIX regex. Match is case sensitive.
 func NotMatchRegexCase(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "!~", driver.Value(pattern)}
+		return dialectOp(schema, opNotMatchRegexCase, col, driver.Value(pattern))
 	}
 }
 
@@ -372,7 +381,7 @@ func NotMatchRegexCase(col SchemaField, pattern string) Condition {
 // match the given POSIX regex. Match is case insensitive.
 func NotMatchRegex(col SchemaField, pattern string) Condition {
 	return func(schema Schema) ToSqler {
-		return &colOp{col.QualifiedName(schema), "!~*", driver.Value(pattern)}
+		return dialectOp(schema, opNotMatchRegex, col, driver.Value(pattern))
 	}
 }
 
@@ -387,13 +396,8 @@ type (
 		value interface{}
 	}
 
-	colUnaryOp struct {
-		col string
-		op  string
-	}
-
 	errOp struct {
-		msg string
+		err error
 	}
 
 	containsAny struct {
@@ -415,12 +419,8 @@ func (o colOp) ToSql() (string, []interface{}, error) {
 	return fmt.Sprintf("%s %s ?", o.col, o.op), []interface{}{o.value}, nil
 }
 
-func (o colUnaryOp) ToSql() (string, []interface{}, error) {
-	return fmt.Sprintf("%s %s", o.col, o.op), nil, nil
-}
-
 func (o errOp) ToSql() (string, []interface{}, error) {
-	return "", nil, errors.New(o.msg)
+	return "", nil, o.err
 }
 
 func (o containsAny) ToSql() (string, []interface{}, error) {
@@ -0,0 +1,250 @@
+package kallax
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+var (
+	// ErrInvalidPageCursor is returned when a query is run with a page
+	// cursor that can not be decoded or does not match its order.
+	ErrInvalidPageCursor = errors.New("kallax: invalid page cursor")
+	// ErrPageCursorNotSupported is returned when the page cursor of a row is
+	// requested to a result set whose query is not ordered or does not select
+	// the columns it is ordered by and the primary key.
+	ErrPageCursorNotSupported = errors.New("kallax: page cursors require an ordered query selecting its order columns and primary key")
+)
+
+// PageCursor is an opaque cursor pointing to a row of the results of a
+// query, which is returned by the Cursor method of the result sets. It can
+// be given to the After and Before methods of a query with the same order to
+// retrieve the rows following or preceding that row.
+type PageCursor string
+
+// keysetColumn is one of the columns that determine the position of a row
+// in the order of a query. notNull is set for the columns known to never be
+// NULL, such as the primary key.
+type keysetColumn struct {
+	col     SchemaField
+	desc    bool
+	notNull bool
+}
+
+// keysetOrders returns the SQL of the order of the given keyset columns, in
+// reverse if reversed is true.
+func keysetOrders(schema Schema, keyset []keysetColumn, reversed bool) []string {
+	var orders = make([]string, len(keyset))
+	for i, k := range keyset {
+		order := asc
+		if k.desc != reversed {
+			order = desc
+		}
+		orders[i] = (&colOrder{order, k.col}).ToSql(schema)
+	}
+	return orders
+}
+
+// keysetCondition returns the condition matching the rows after the given
+// values of the keyset columns, or before them if before is true. NULL values
+// are placed where the dialect sorts them.
+func keysetCondition(keyset []keysetColumn, values []interface{}, before bool) Condition {
+	return func(schema Schema) ToSqler {
+		nullsLast := dialectOf(schema).nullsLast()
+		var conds []Condition
+		for i, k := range keyset {
+			var and []Condition
+			for j := 0; j < i; j++ {
+				and = append(and, Eq(keyset[j].col, values[j]))
+			}
+
+			// whether the NULL values come after the rest in the direction
+			// the rows are iterated
+			greater := k.desc == before
+			nullsAfter := nullsLast == greater && !k.notNull
+
+			var cond Condition
+			switch {
+			case values[i] == nil && nullsAfter:
+				// no row follows a NULL value in this column
+				continue
+			case values[i] == nil:
+				cond = Neq(k.col, nil)
+			case greater:
+				cond = Gt(k.col, values[i])
+			default:
+				cond = Lt(k.col, values[i])
+			}
+
+			if values[i] != nil && nullsAfter {
+				cond = Or(cond, Eq(k.col, nil))
+			}
+			conds = append(conds, And(append(and, cond)...))
+		}
+
+		if len(conds) == 0 {
+			return squirrel.Expr("1 = 0")
+		}
+		return Or(conds...)(schema)
+	}
+}
+
+// pageCondition returns the condition matching the rows after or before the
+// row of the given cursor, which fails to compile if it is not valid.
+func pageCondition(keyset []keysetColumn, cursor PageCursor, before bool) Condition {
+	values, err := decodePageCursor(cursor, len(keyset))
+	if err != nil {
+		return func(Schema) ToSqler {
+			return errSqler{err}
+		}
+	}
+	return keysetCondition(keyset, values, before)
+}
+
+// errSqler is a condition that always fails to compile with an error.
+type errSqler struct {
+	err error
+}
+
+func (s errSqler) ToSql() (string, []interface{}, error) {
+	return "", nil, s.err
+}
+
+// keysetValues returns the values of the keyset columns in the given record,
+// which must be in the given selected columns.
+func keysetValues(record Record, keyset []keysetColumn, columns []string) ([]interface{}, error) {
+	if len(keyset) == 0 {
+		return nil, ErrPageCursorNotSupported
+	}
+
+	values := make([]interface{}, len(keyset))
+	for i, k := range keyset {
+		name := k.col.String()
+		if !containsString(columns, name) {
+			return nil, ErrPageCursorNotSupported
+		}
+
+		v, err := record.Value(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if values[i], err = driver.DefaultParameterConverter.ConvertValue(v); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// pageCursorOf returns the page cursor of the given record, or an empty one
+// if there is no record.
+func pageCursorOf(record Record, keyset []keysetColumn, columns []string) (PageCursor, error) {
+	if record == nil {
+		return "", nil
+	}
+
+	values, err := keysetValues(record, keyset, columns)
+	if err != nil {
+		return "", err
+	}
+	return encodePageCursor(values)
+}
+
+// encodePageCursor returns the page cursor of a row with the given values of
+// the keyset columns, which must be driver values. Every value is encoded
+// along with its type, so it is passed to the database as the same type.
+func encodePageCursor(values []interface{}) (PageCursor, error) {
+	encoded := make([]*string, len(values))
+	for i, v := range values {
+		var s string
+		switch v := v.(type) {
+		case nil:
+			continue
+		case int64:
+			s = "i:" + strconv.FormatInt(v, 10)
+		case float64:
+			s = "f:" + strconv.FormatFloat(v, 'g', -1, 64)
+		case bool:
+			s = "b:" + strconv.FormatBool(v)
+		case string:
+			s = "s:" + v
+		case []byte:
+			s = "x:" + hex.EncodeToString(v)
+		case time.Time:
+			s = "t:" + v.Format(time.RFC3339Nano)
+		default:
+			return "", fmt.Errorf("kallax: can't encode value of type %T in a page cursor", v)
+		}
+		encoded[i] = &s
+	}
+
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return "", err
+	}
+	return PageCursor(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// decodePageCursor returns the n values of the keyset columns encoded in the
+// given page cursor.
+func decodePageCursor(cursor PageCursor, n int) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return nil, ErrInvalidPageCursor
+	}
+
+	var encoded []*string
+	if err := json.Unmarshal(data, &encoded); err != nil || len(encoded) != n {
+		return nil, ErrInvalidPageCursor
+	}
+
+	values := make([]interface{}, n)
+	for i, s := range encoded {
+		if s == nil {
+			continue
+		}
+
+		if len(*s) < 2 || (*s)[1] != ':' {
+			return nil, ErrInvalidPageCursor
+		}
+
+		v := (*s)[2:]
+		switch (*s)[0] {
+		case 'i':
+			values[i], err = strconv.ParseInt(v, 10, 64)
+		case 'f':
+			values[i], err = strconv.ParseFloat(v, 64)
+		case 'b':
+			values[i], err = strconv.ParseBool(v)
+		case 's':
+			values[i] = v
+		case 'x':
+			values[i], err = hex.DecodeString(v)
+		case 't':
+			values[i], err = time.Parse(time.RFC3339Nano, v)
+		default:
+			err = ErrInvalidPageCursor
+		}
+
+		if err != nil {
+			return nil, ErrInvalidPageCursor
+		}
+	}
+	return values, nil
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package kallax
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCursor(t *testing.T) {
+	require := require.New(t)
+	values := []interface{}{
+		nil,
+		int64(42),
+		1.5,
+		true,
+		"foo:bar",
+		[]byte{0, 1, 2},
+		time.Date(2017, 3, 4, 5, 6, 7, 8, time.UTC),
+	}
+
+	cursor, err := encodePageCursor(values)
+	require.NoError(err)
+
+	decoded, err := decodePageCursor(cursor, len(values))
+	require.NoError(err)
+	require.Equal(values, decoded)
+
+	_, err = decodePageCursor(cursor, len(values)-1)
+	require.Equal(ErrInvalidPageCursor, err)
+
+	for _, c := range []PageCursor{"", "foo", "WyJ6OjEiXQ"} {
+		_, err = decodePageCursor(c, 1)
+		require.Equal(ErrInvalidPageCursor, err, "%s", c)
+	}
+}
+
+func TestPageCursorOf(t *testing.T) {
+	require := require.New(t)
+	m := newModel("foo", "", 42)
+	m.ID = 7
+	keyset := []keysetColumn{{col: f("age"), desc: true}, {col: f("id"), notNull: true}}
+
+	cursor, err := pageCursorOf(nil, keyset, nil)
+	require.NoError(err)
+	require.Equal(PageCursor(""), cursor)
+
+	cursor, err = pageCursorOf(m, keyset, []string{"id", "age"})
+	require.NoError(err)
+
+	values, err := decodePageCursor(cursor, 2)
+	require.NoError(err)
+	require.Equal([]interface{}{int64(42), int64(7)}, values)
+
+	_, err = pageCursorOf(m, keyset, []string{"age"})
+	require.Equal(ErrPageCursorNotSupported, err)
+
+	_, err = pageCursorOf(m, nil, []string{"age"})
+	require.Equal(ErrPageCursorNotSupported, err)
+}
+
+func TestKeysetCondition(t *testing.T) {
+	keyset := []keysetColumn{{col: f("name")}, {col: f("id"), notNull: true}}
+	cases := []struct {
+		dialect Dialect
+		values  []interface{}
+		before  bool
+		sql     string
+		args    []interface{}
+	}{
+		{
+			PostgreSQL,
+			[]interface{}{"foo", int64(7)},
+			false,
+			"(((__model.name > ? OR __model.name IS NULL)) OR (__model.name = ? AND __model.id > ?))",
+			[]interface{}{"foo", "foo", int64(7)},
+		},
+		{
+			PostgreSQL,
+			[]interface{}{nil, int64(7)},
+			false,
+			"((__model.name IS NULL AND __model.id > ?))",
+			[]interface{}{int64(7)},
+		},
+		{
+			PostgreSQL,
+			[]interface{}{nil, int64(7)},
+			true,
+			"((__model.name IS NOT NULL) OR (__model.name IS NULL AND __model.id < ?))",
+			[]interface{}{int64(7)},
+		},
+		{
+			MySQL,
+			[]interface{}{"foo", int64(7)},
+			false,
+			"((__model.name > ?) OR (__model.name = ? AND __model.id > ?))",
+			[]interface{}{"foo", "foo", int64(7)},
+		},
+		{
+			MySQL,
+			[]interface{}{nil, int64(7)},
+			false,
+			"((__model.name IS NOT NULL) OR (__model.name IS NULL AND __model.id > ?))",
+			[]interface{}{int64(7)},
+		},
+		{
+			MySQL,
+			[]interface{}{nil, int64(7)},
+			true,
+			"((__model.name IS NULL AND __model.id < ?))",
+			[]interface{}{int64(7)},
+		},
+	}
+
+	for _, c := range cases {
+		cond := keysetCondition(keyset, c.values, c.before)
+		sql, args, err := cond(withDialect(ModelSchema, c.dialect)).ToSql()
+		require.NoError(t, err)
+		require.Equal(t, c.sql, sql, "%v", c.values)
+		require.Equal(t, c.args, args)
+	}
+}
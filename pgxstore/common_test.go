@@ -0,0 +1,210 @@
+package pgxstore
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-kallax.v1"
+)
+
+func envOrDefault(key string, def string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		v = def
+	}
+	return v
+}
+
+func testDBURL() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s/%s?sslmode=disable",
+		envOrDefault("DBUSER", "testing"),
+		envOrDefault("DBPASS", "testing"),
+		envOrDefault("DBHOST", "0.0.0.0:5432"),
+		envOrDefault("DBNAME", "testing"),
+	)
+}
+
+func setupTables(t *testing.T, pool *pgx.ConnPool) {
+	_, err := pool.Exec(`CREATE TABLE IF NOT EXISTS model (
+		id serial PRIMARY KEY,
+		name varchar(255) not null,
+		email varchar(255) not null,
+		age int not null
+	)`)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(`CREATE TABLE IF NOT EXISTS rel (
+		id serial PRIMARY KEY,
+		model_id integer,
+		foo text
+	)`)
+	require.NoError(t, err)
+}
+
+func teardownTables(t *testing.T, pool *pgx.ConnPool) {
+	_, err := pool.Exec("DROP TABLE model")
+	require.NoError(t, err)
+	_, err = pool.Exec("DROP TABLE rel")
+	require.NoError(t, err)
+}
+
+type model struct {
+	kallax.Model
+	ID    int64 `pk:"autoincr"`
+	Name  string
+	Email string
+	Age   int
+	Rels  []*rel
+}
+
+func newModel(name, email string, age int) *model {
+	m := &model{Model: kallax.NewModel(), Name: name, Email: email, Age: age}
+	return m
+}
+
+func (m *model) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return m.ID, nil
+	case "name":
+		return m.Name, nil
+	case "email":
+		return m.Email, nil
+	case "age":
+		return m.Age, nil
+	}
+	return nil, fmt.Errorf("kallax: column does not exist: %s", col)
+}
+
+func (m *model) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return &m.ID, nil
+	case "name":
+		return &m.Name, nil
+	case "email":
+		return &m.Email, nil
+	case "age":
+		return &m.Age, nil
+	}
+	return nil, fmt.Errorf("kallax: column does not exist: %s", col)
+}
+
+func (m *model) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "rels":
+		return new(rel), nil
+	}
+	return nil, fmt.Errorf("kallax: no relationship found for field %s", field)
+}
+
+func (m *model) SetRelationship(field string, record interface{}) error {
+	switch field {
+	case "rels":
+		rels, ok := record.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: can't set relationship %s with value of type %T", field, record)
+		}
+		m.Rels = make([]*rel, len(rels))
+		for i, r := range rels {
+			rel, ok := r.(*rel)
+			if !ok {
+				return fmt.Errorf("kallax: can't set element of relationship %s with element of type %T", field, r)
+			}
+			m.Rels[i] = rel
+		}
+		return nil
+	}
+	return fmt.Errorf("kallax: no relationship found for field %s", field)
+}
+
+func (m *model) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&m.ID)
+}
+
+type rel struct {
+	kallax.Model
+	ID  int64 `pk:"autoincr"`
+	Foo string
+}
+
+func newRel(id kallax.Identifier, foo string) *rel {
+	rel := &rel{kallax.NewModel(), 0, foo}
+	rel.AddVirtualColumn("model_id", id)
+	return rel
+}
+
+func (r *rel) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
+}
+
+func (m *rel) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return m.ID, nil
+	case "model_id":
+		return m.VirtualColumn(col), nil
+	case "foo":
+		return m.Foo, nil
+	}
+	return nil, fmt.Errorf("kallax: column does not exist: %s", col)
+}
+
+func (m *rel) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return &m.ID, nil
+	case "model_id":
+		return kallax.VirtualColumn(col, m, new(kallax.NumericID)), nil
+	case "foo":
+		return &m.Foo, nil
+	}
+	return nil, fmt.Errorf("kallax: column does not exist: %s", col)
+}
+
+func (m *rel) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: no relationship found for field %s", field)
+}
+
+func (m *rel) SetRelationship(field string, record interface{}) error {
+	return fmt.Errorf("kallax: no relationship found for field %s", field)
+}
+
+var ModelSchema = kallax.NewBaseSchema(
+	"model",
+	"__model",
+	f("id"),
+	kallax.ForeignKeys{
+		"rels": kallax.NewForeignKey("model_id", false),
+	},
+	func() kallax.Record {
+		return new(model)
+	},
+	true,
+	f("id"),
+	f("name"),
+	f("email"),
+	f("age"),
+)
+
+var RelSchema = kallax.NewBaseSchema(
+	"rel",
+	"__rel",
+	f("id"),
+	kallax.ForeignKeys{},
+	func() kallax.Record {
+		return new(rel)
+	},
+	true,
+	f("id"),
+	f("model_id"),
+	f("foo"),
+)
+
+func f(name string) kallax.SchemaField {
+	return kallax.NewSchemaField(name)
+}
@@ -0,0 +1,169 @@
+// Package pgxstore provides kallax stores whose rows are read natively by
+// pgx. It requires Go 1.10 or newer.
+package pgxstore // import "gopkg.in/src-d/go-kallax.v1/pgxstore"
+
+import (
+	"context"
+	"io"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/stdlib"
+	"gopkg.in/src-d/go-kallax.v1"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+// NewStore returns a new kallax Store instance for a PostgreSQL database
+// using the given pgx connection pool. The queries of the result sets
+// returned by the store, including the ones of their relationships, are run
+// directly on the pool, so their rows are read with the binary protocol and
+// the ids, UUIDs, arrays of strings, int64, float64 and bool, and JSON
+// columns are decoded with the native codecs of pgx instead of through
+// database/sql. The rest of the statements, as well as the transactions and
+// raw queries, are run with the database/sql driver of pgx on the same pool.
+//
+// The generated stores can be created with it by setting their store, e.g.
+// &UserStore{pgxstore.NewStore(pool)}.
+func NewStore(pool *pgx.ConnPool) *kallax.Store {
+	return kallax.NewStoreWithNativeDriver(stdlib.OpenDBFromPool(pool), &driver{pool})
+}
+
+// driver is the native driver of the stores, which runs the statements on a
+// pgx connection pool.
+type driver struct {
+	pool *pgx.ConnPool
+}
+
+func (d *driver) Query(ctx context.Context, query string, args ...interface{}) (kallax.NativeRows, error) {
+	rows, err := d.pool.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	return nativeRows{rows}, nil
+}
+
+// CopyFrom runs the given COPY FROM statement in its own transaction on a
+// connection of the pool, streaming the data of the given reader. The
+// transaction is rolled back if the reader fails.
+func (d *driver) CopyFrom(ctx context.Context, query string, src io.Reader) (int64, error) {
+	conn, err := d.pool.Acquire()
+	if err != nil {
+		return 0, err
+	}
+	defer d.pool.Release(conn)
+
+	tx, err := conn.BeginEx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := copyData(src, func(r io.Reader) (pgx.CommandTag, error) {
+		return conn.CopyFromReader(r, query)
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.CommitEx(ctx); err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// copyData runs the given copy with a reader of the data of the source. pgx
+// does not abort the copy when its reader fails, so the data is ended
+// instead and the error of the source is returned if the copy succeeds.
+// The source is never read once it returns.
+func copyData(src io.Reader, run func(io.Reader) (pgx.CommandTag, error)) (pgx.CommandTag, error) {
+	var srcErr error
+	done := make(chan struct{})
+	r, w := io.Pipe()
+	go func() {
+		defer close(done)
+		defer w.Close()
+		_, srcErr = io.Copy(w, src)
+	}()
+
+	tag, err := run(r)
+	// the source may still be read if the copy failed, so the pipe is
+	// closed to stop it and it is waited for
+	r.CloseWithError(err)
+	<-done
+	if err == nil {
+		err = srcErr
+	}
+	return tag, err
+}
+
+// CopyTo runs the given COPY TO statement on a connection of the pool
+// acquired with the given context, writing the rows to the given writer.
+// pgx does not support contexts for it, so the connection is closed to abort
+// it once the context is done, and is then discarded by the pool.
+func (d *driver) CopyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	conn, err := d.pool.AcquireEx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	tag, err := conn.CopyToWriter(w, query)
+	close(done)
+	<-stopped
+	d.pool.Release(conn)
+
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return 0, ctxErr
+		}
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// nativeRows are the result rows of a query run with pgx.
+type nativeRows struct {
+	*pgx.Rows
+}
+
+func (r nativeRows) Close() error {
+	r.Rows.Close()
+	return nil
+}
+
+func (r nativeRows) Columns() ([]string, error) {
+	fields := r.FieldDescriptions()
+	columns := make([]string, len(fields))
+	for i, f := range fields {
+		columns[i] = f.Name
+	}
+	return columns, nil
+}
+
+// Column returns the given column address converted to the one pgx can
+// decode natively into, or the same address if there is none.
+func (r nativeRows) Column(ptr interface{}) interface{} {
+	return column(ptr)
+}
+
+func column(ptr interface{}) interface{} {
+	switch v := ptr.(type) {
+	case *kallax.ULID:
+		return (*[16]byte)(v)
+	case *kallax.UUID:
+		return (*[16]byte)(v)
+	}
+
+	if v, ok := types.Unwrap(ptr); ok {
+		return v
+	}
+	return ptr
+}
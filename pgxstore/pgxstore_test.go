@@ -0,0 +1,234 @@
+package pgxstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/src-d/go-kallax.v1"
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+func TestColumn(t *testing.T) {
+	require := require.New(t)
+	var (
+		id   kallax.ULID
+		uuid kallax.UUID
+		strs []string
+		ints []int
+		m    map[string]interface{}
+		name string
+	)
+
+	require.Equal((*[16]byte)(&id), column(&id))
+	require.Equal((*[16]byte)(&uuid), column(&uuid))
+	require.Equal(&strs, column(types.Slice(&strs)))
+	require.Equal(&m, column(types.JSON(&m)))
+	require.Equal(&name, column(&name))
+
+	ptr := types.Slice(&ints)
+	require.Equal(ptr, column(ptr))
+}
+
+func TestErrors(t *testing.T) {
+	require := require.New(t)
+	require.True(kallax.IsRetryableError(pgx.PgError{Code: "40001"}))
+	require.False(kallax.IsRetryableError(pgx.PgError{Code: "23505"}))
+}
+
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+func TestCopyData(t *testing.T) {
+	require := require.New(t)
+
+	var data []byte
+	tag, err := copyData(strings.NewReader("foo\n"), func(r io.Reader) (pgx.CommandTag, error) {
+		var err error
+		data, err = ioutil.ReadAll(r)
+		return "COPY 1", err
+	})
+	require.NoError(err)
+	require.Equal(int64(1), tag.RowsAffected())
+	require.Equal("foo\n", string(data))
+
+	src := readerFunc(func([]byte) (int, error) {
+		return 0, errors.New("foo")
+	})
+	_, err = copyData(src, func(r io.Reader) (pgx.CommandTag, error) {
+		_, err := ioutil.ReadAll(r)
+		return "COPY 0", err
+	})
+	require.EqualError(err, "foo")
+}
+
+func TestCopyData_Fail(t *testing.T) {
+	require := require.New(t)
+
+	release := make(chan struct{})
+	src := readerFunc(func(p []byte) (int, error) {
+		<-release
+		return copy(p, "foo\n"), nil
+	})
+
+	returned := make(chan error)
+	go func() {
+		_, err := copyData(src, func(io.Reader) (pgx.CommandTag, error) {
+			return "", errors.New("foo")
+		})
+		returned <- err
+	}()
+
+	// it does not return while the source is still being read
+	select {
+	case <-returned:
+		require.FailNow("copyData returned before the source was done")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.EqualError(<-returned, "foo")
+}
+
+type StoreSuite struct {
+	suite.Suite
+	pool  *pgx.ConnPool
+	store *kallax.Store
+}
+
+func (s *StoreSuite) SetupTest() {
+	config, err := pgx.ParseURI(testDBURL())
+	s.Require().NoError(err)
+
+	s.pool, err = pgx.NewConnPool(pgx.ConnPoolConfig{ConnConfig: config})
+	s.Require().NoError(err)
+
+	s.store = NewStore(s.pool)
+	setupTables(s.T(), s.pool)
+}
+
+func (s *StoreSuite) TearDownTest() {
+	teardownTables(s.T(), s.pool)
+	s.pool.Close()
+}
+
+func (s *StoreSuite) TestFind() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "joe@foo.bar", 1)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Jane", "jane@foo.bar", 2)))
+
+	q := kallax.NewBaseQuery(ModelSchema)
+	q.Where(kallax.Gt(f("age"), 1))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	var names []string
+	for rs.Next() {
+		record, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		s.True(record.IsPersisted())
+		s.True(record.IsWritable())
+		names = append(names, record.(*model).Name)
+	}
+	s.NoError(rs.Close())
+	s.Equal([]string{"Jane"}, names)
+}
+
+func (s *StoreSuite) TestFind_Relationships() {
+	m := newModel("Joe", "joe@foo.bar", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+	for i := 0; i < 3; i++ {
+		s.NoError(s.store.Insert(RelSchema, newRel(m.GetID(), fmt.Sprint(i))))
+	}
+
+	q := kallax.NewBaseQuery(ModelSchema)
+	s.NoError(q.AddRelation(RelSchema, "rels", kallax.OneToMany, nil))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	s.True(rs.Next())
+	record, err := rs.Get(ModelSchema)
+	s.NoError(err)
+	s.Len(record.(*model).Rels, 3)
+	s.False(rs.Next())
+	s.NoError(rs.Close())
+}
+
+func (s *StoreSuite) TestReload() {
+	m := newModel("Joe", "joe@foo.bar", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	reloaded := &model{Model: kallax.NewModel(), ID: m.ID}
+	s.NoError(s.store.Reload(ModelSchema, reloaded))
+	s.Equal("Joe", reloaded.Name)
+	s.Equal(1, reloaded.Age)
+}
+
+func (s *StoreSuite) TestTransaction() {
+	s.NoError(s.store.Transaction(func(store *kallax.Store) error {
+		return store.Insert(ModelSchema, newModel("Joe", "joe@foo.bar", 1))
+	}))
+
+	count, err := s.store.Count(kallax.NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	s.Equal(int64(1), count)
+}
+
+func (s *StoreSuite) TestCopyFrom() {
+	n, err := s.store.CopyFrom(ModelSchema, kallax.NewRecordIterator(
+		newModel("Joe", "joe@foo.bar", 1),
+		newModel("Jane\tDoe", "", 2),
+	))
+	s.NoError(err)
+	s.Equal(int64(2), n)
+
+	count, err := s.store.Count(kallax.NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	s.Equal(int64(2), count)
+}
+
+func (s *StoreSuite) TestCopyFrom_Fail() {
+	var i int
+	_, err := s.store.CopyFrom(ModelSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		if i++; i > 2 {
+			return nil, errors.New("foo")
+		}
+		return newModel("Joe", "", i), nil
+	}))
+	s.EqualError(err, "foo")
+
+	count, err := s.store.Count(kallax.NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	s.Equal(int64(0), count)
+}
+
+func (s *StoreSuite) TestCopyTo() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "joe@foo.bar", 1)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Jane, Doe", "jane@foo.bar", 2)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Jim", "jim@foo.bar", 3)))
+
+	q := kallax.NewBaseQuery(ModelSchema)
+	q.Select(f("name"), f("age"))
+	q.Where(kallax.Lt(f("age"), 3))
+	q.Order(kallax.Asc(f("age")))
+
+	var buf bytes.Buffer
+	n, err := s.store.CopyTo(q, &buf, kallax.CopyCSV)
+	s.NoError(err)
+	s.Equal(int64(2), n)
+	s.Equal("Joe,1\n\"Jane, Doe\",2\n", buf.String())
+}
+
+func TestStore(t *testing.T) {
+	suite.Run(t, new(StoreSuite))
+}
@@ -0,0 +1,230 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-kallax.v1/types"
+)
+
+var (
+	// ErrInvalidProjectionDest is returned when the destination given to
+	// FindInto is not a pointer to a slice of structs or of pointers to
+	// structs.
+	ErrInvalidProjectionDest = errors.New("kallax: projection destination must be a pointer to a slice of structs")
+	// ErrProjectionRelationships is returned when a query with relationships
+	// is given to FindInto.
+	ErrProjectionRelationships = errors.New("kallax: queries with relationships can not be projected")
+)
+
+// Expression is a SQL expression over some fields that can be selected by a
+// query as any other field, so its value can be retrieved with FindInto.
+type Expression struct {
+	name   string
+	format string
+	fields []SchemaField
+}
+
+// NewExpression returns a new expression with the given name, which is the
+// one of its column in the results, and format. You can put `:col:` on the
+// format wherever you want the name of each of the given fields to be, in the
+// same order.
+// Example: NewExpression("full_name", "concat(:col:, ' ', :col:)", first, last).
+func NewExpression(name, format string, fields ...SchemaField) *Expression {
+	return &Expression{name, format, fields}
+}
+
+func (*Expression) isSchemaField() {}
+
+// String returns the name of the expression column.
+func (e *Expression) String() string {
+	return e.name
+}
+
+// QualifiedName returns the SQL of the expression with its fields qualified
+// by the alias of the given schema.
+func (e *Expression) QualifiedName(schema Schema) string {
+	sql := e.format
+	for _, f := range e.fields {
+		sql = strings.Replace(sql, ":col:", f.QualifiedName(schema), 1)
+	}
+	return sql
+}
+
+// FindInto stores the rows selected by the given query in the given
+// destination, which must be a pointer to a slice of structs, or of pointers
+// to structs, with a field for every selected column, instead of retrieving
+// them as records. The query can select any column or expression, e.g. to
+// read lightweight views of a model. See FindIntoContext for more details.
+func (s *Store) FindInto(q Query, dest interface{}) error {
+	return s.FindIntoContext(context.Background(), q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// destination using the given context. The fields are matched with the
+// columns by the name in their kallax or column tag or, if they have none, by
+// their name ignoring case and underscores, e.g. the CreatedAt field for the
+// created_at column. Slices are scanned as arrays and maps and structs as
+// JSON, as they are in the models. The rest of values are scanned the same
+// way database/sql does, so the fields of columns that can be NULL must be
+// pointers or sql.Null types. Queries with relationships can not be used.
+func (s *Store) FindIntoContext(ctx context.Context, q Query, dest interface{}) (err error) {
+	slice, ok := newStructSlice(dest)
+	if !ok {
+		return ErrInvalidProjectionDest
+	}
+
+	if len(q.getRelationships()) > 0 {
+		return ErrProjectionRelationships
+	}
+
+	if err := s.checkLock(q); err != nil {
+		return err
+	}
+
+	ctx, span := s.startOperation(ctx, FindOperation, q.Schema().Table())
+	defer func() { span.Finish(err) }()
+
+	columns, builder := q.compile(s.dialect)
+	if offset := q.GetOffset(); offset > 0 {
+		builder = builder.Offset(offset)
+	}
+
+	if limit := q.GetLimit(); limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	rows, err := querySelect(ctx, s.proxy, builder)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	start := slice.slice.Len()
+	native, _ := rows.(NativeRows)
+	for rows.Next() {
+		err := slice.scan(columns, func(ptrs ...interface{}) error {
+			for i, ptr := range ptrs {
+				ptrs[i] = s.dialect.column(projectionColumn(ptr))
+				if native != nil {
+					ptrs[i] = native.Column(ptrs[i])
+				}
+			}
+			return rows.Scan(ptrs...)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// the rows of reversed queries are retrieved in reverse order
+	if q.isReversed() {
+		slice.reverse(start)
+	}
+	return nil
+}
+
+// projectionColumn returns the given address of a field of a projection
+// converted to the way the models store the values of its type.
+func projectionColumn(ptr interface{}) interface{} {
+	if _, ok := ptr.(sql.Scanner); ok {
+		return ptr
+	}
+
+	switch typ := reflect.TypeOf(ptr).Elem(); typ.Kind() {
+	case reflect.Slice:
+		if typ.Elem().Kind() != reflect.Uint8 {
+			return types.Slice(ptr)
+		}
+	case reflect.Map:
+		return types.JSON(ptr)
+	case reflect.Struct:
+		if typ != reflect.TypeOf(time.Time{}) {
+			return types.JSON(ptr)
+		}
+	}
+	return ptr
+}
+
+// structSlice is a slice of structs, or of pointers to structs, in which
+// rows are stored by matching their columns with the fields of the structs.
+type structSlice struct {
+	slice reflect.Value
+	elem  reflect.Type
+	isPtr bool
+}
+
+// newStructSlice returns the struct slice pointed by the given destination,
+// reporting whether it is a pointer to a slice of structs or of pointers to
+// structs.
+func newStructSlice(dest interface{}) (*structSlice, bool) {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	s := &structSlice{slice: v.Elem(), elem: v.Elem().Type().Elem()}
+	if s.elem.Kind() == reflect.Ptr {
+		s.isPtr = true
+		s.elem = s.elem.Elem()
+	}
+	return s, s.elem.Kind() == reflect.Struct
+}
+
+// scan appends a new struct to the slice, scanning the given columns into
+// its fields with the given function.
+func (s *structSlice) scan(columns []string, scan func(...interface{}) error) error {
+	v := reflect.New(s.elem)
+	ptrs := make([]interface{}, len(columns))
+	for i, col := range columns {
+		field, ok := columnField(s.elem, col)
+		if !ok {
+			return fmt.Errorf("kallax: no field for column %s in %s", col, s.elem)
+		}
+		ptrs[i] = v.Elem().FieldByIndex(field.Index).Addr().Interface()
+	}
+
+	if err := scan(ptrs...); err != nil {
+		return err
+	}
+
+	if !s.isPtr {
+		v = v.Elem()
+	}
+	s.slice.Set(reflect.Append(s.slice, v))
+	return nil
+}
+
+// reverse reverses the order of the elements of the slice from the given
+// index on.
+func (s *structSlice) reverse(from int) {
+	swap := reflect.Swapper(s.slice.Interface())
+	for i, j := from, s.slice.Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+// columnField returns the field of the given struct type for the column with
+// the given name.
+func columnField(typ reflect.Type, column string) (reflect.StructField, bool) {
+	name := strings.Replace(column, "_", "", -1)
+	return typ.FieldByNameFunc(func(fieldName string) bool {
+		field, _ := typ.FieldByName(fieldName)
+		for _, key := range []string{"kallax", "column"} {
+			if tag := strings.Split(field.Tag.Get(key), ",")[0]; tag != "" {
+				return tag == column
+			}
+		}
+		return strings.EqualFold(fieldName, name)
+	})
+}
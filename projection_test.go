@@ -0,0 +1,140 @@
+package kallax
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression(t *testing.T) {
+	require := require.New(t)
+	e := NewExpression("full_name", "concat(:col:, ' ', :col:)", f("name"), f("email"))
+	require.Equal("full_name", e.String())
+	require.Equal("concat(__model.name, ' ', __model.email)", e.QualifiedName(ModelSchema))
+}
+
+func TestColumnField(t *testing.T) {
+	type result struct {
+		Email  string
+		SumAge int64
+		Names  int64 `kallax:"count_distinct_name"`
+		Rel    int64 `column:"rel_id,omitempty"`
+	}
+
+	typ := reflect.TypeOf(result{})
+	for col, expected := range map[string]string{
+		"email":               "Email",
+		"sum_age":             "SumAge",
+		"count_distinct_name": "Names",
+		"rel_id":              "Rel",
+	} {
+		field, ok := columnField(typ, col)
+		require.True(t, ok, col)
+		require.Equal(t, expected, field.Name)
+	}
+
+	_, ok := columnField(typ, "names")
+	require.False(t, ok)
+}
+
+// valueRows are result rows with the given values.
+type valueRows struct {
+	values [][]interface{}
+	row    int
+}
+
+func (r *valueRows) Next() bool {
+	r.row++
+	return r.row <= len(r.values)
+}
+
+func (r *valueRows) Scan(dest ...interface{}) error {
+	for i, v := range r.values[r.row-1] {
+		if s, ok := dest[i].(sql.Scanner); ok {
+			if err := s.Scan(v); err != nil {
+				return err
+			}
+			continue
+		}
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(v))
+	}
+	return nil
+}
+
+func (r *valueRows) Columns() ([]string, error) { return nil, nil }
+func (r *valueRows) Err() error                 { return nil }
+func (r *valueRows) Close() error               { return nil }
+
+func TestStoreFindInto(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: &valueRows{values: [][]interface{}{
+		{"a", []byte(`{"a","b"}`), []byte(`{"n":1}`)},
+		{"b", []byte(`{}`), []byte(`{"n":2}`)},
+	}}}
+	store := (&Store{runner: fake}).build()
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"), NewExpression("tags", "array[:col:]", f("email")), f("data"))
+	q.Order(Asc(f("name")))
+	q.Limit(2)
+
+	var result []*struct {
+		Name string
+		Tags []string
+		Meta struct{ N int } `kallax:"data"`
+	}
+	require.NoError(store.FindInto(q, &result))
+	require.Equal([]string{
+		"SELECT __model.name, array[__model.email], __model.data FROM model __model ORDER BY __model.name ASC LIMIT 2",
+	}, fake.queries)
+
+	require.Len(result, 2)
+	require.Equal("a", result[0].Name)
+	require.Equal([]string{"a", "b"}, result[0].Tags)
+	require.Equal(1, result[0].Meta.N)
+	require.Equal("b", result[1].Name)
+	require.Equal([]string{}, result[1].Tags)
+	require.Equal(2, result[1].Meta.N)
+}
+
+func TestStoreFindInto_Reversed(t *testing.T) {
+	require := require.New(t)
+
+	fake := &fakeRowsProxy{rows: &valueRows{values: [][]interface{}{
+		{"c"}, {"b"},
+	}}}
+	store := (&Store{runner: fake}).build()
+
+	cursor, err := encodePageCursor([]interface{}{"d", int64(4)})
+	require.NoError(err)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"))
+	q.Order(Asc(f("name")))
+	q.Before(cursor)
+	q.Limit(2)
+
+	result := []struct{ Name string }{{"a"}}
+	require.NoError(store.FindInto(q, &result))
+	require.Equal([]string{
+		"SELECT __model.name FROM model __model WHERE ((__model.name < $1) OR (__model.name = $2 AND __model.id < $3)) ORDER BY __model.name DESC, __model.id DESC LIMIT 2",
+	}, fake.queries)
+	require.Equal([]struct{ Name string }{{"a"}, {"b"}, {"c"}}, result)
+}
+
+func TestStoreFindInto_Invalid(t *testing.T) {
+	require := require.New(t)
+	store := (&Store{runner: &fakeRowsProxy{rows: fakeRows{}}}).build()
+	q := NewBaseQuery(ModelSchema)
+
+	for _, dest := range []interface{}{nil, []struct{}{}, &[]int{}, new(struct{})} {
+		require.Equal(ErrInvalidProjectionDest, store.FindInto(q, dest))
+	}
+
+	var result []struct{ Name string }
+	require.NoError(q.AddRelation(RelSchema, "rel", OneToOne, nil))
+	require.Equal(ErrProjectionRelationships, store.FindInto(q, &result))
+}
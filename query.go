@@ -17,8 +17,10 @@ var (
 // of a query are compiling themselves to something executable and return
 // some query settings.
 type Query interface {
-	compile() ([]string, squirrel.SelectBuilder)
+	compile(Dialect) ([]string, squirrel.SelectBuilder)
+	compileAggregate(Dialect, []*Aggregate) ([]string, squirrel.SelectBuilder)
 	getRelationships() []Relationship
+	getConditions(Dialect) []ToSqler
 	isReadOnly() bool
 	// Schema returns the schema of the query model.
 	Schema() Schema
@@ -28,8 +30,12 @@ type Query interface {
 	GetLimit() uint64
 	// GetBatchSize returns the number of rows retrieved by the store per
 	// batch. This is only used and has effect on queries with 1:N
-	// relationships.
+	// relationships or using a cursor.
 	GetBatchSize() uint64
+	usesCursor() bool
+	locksRows() bool
+	keyset() []keysetColumn
+	isReversed() bool
 }
 
 type columnSet []SchemaField
@@ -83,19 +89,46 @@ type BaseQuery struct {
 	// relationships
 	relationColumns []string
 	relationships   []Relationship
-	builder         squirrel.SelectBuilder
+	// conditions and orders are compiled along with the query, as their SQL
+	// depends on the dialect.
+	conditions []Condition
+	orders     []ColumnOrder
+	builder    squirrel.SelectBuilder
 
 	selectChanged bool
 	batchSize     uint64
 	offset        uint64
 	limit         uint64
-}
+	deleted       deletedScope
+	// cursor reports whether the rows are fetched in batches from a
+	// server-side cursor.
+	cursor bool
+	// page is the cursor of the row the results start after, or end before
+	// if pageBefore is true.
+	page       PageCursor
+	pageBefore bool
+	// groupBy and having are the fields the rows are grouped by and the
+	// conditions to filter the groups when the query is aggregated.
+	groupBy []SchemaField
+	having  []Condition
+	// lock is the clause locking the rows selected by the query, if any.
+	lock rowLock
+}
+
+// deletedScope defines which rows of a schema with soft delete are retrieved
+// by a query.
+type deletedScope int
+
+const (
+	excludeDeleted deletedScope = iota
+	includeDeleted
+	onlyDeleted
+)
 
 // NewBaseQuery creates a new BaseQuery for querying the table of the given schema.
 func NewBaseQuery(schema Schema) *BaseQuery {
 	return &BaseQuery{
 		builder: squirrel.StatementBuilder.
-			PlaceholderFormat(squirrel.Dollar).
 			Select().
 			From(schema.Table() + " " + schema.Alias()),
 		columns:   columnSet(schema.Columns()),
@@ -138,11 +171,20 @@ func (q *BaseQuery) Copy() *BaseQuery {
 		excludedColumns: q.excludedColumns.copy(),
 		relationColumns: q.relationColumns[:],
 		relationships:   q.relationships[:],
+		conditions:      q.conditions[:len(q.conditions):len(q.conditions)],
+		orders:          q.orders[:len(q.orders):len(q.orders)],
 		selectChanged:   q.selectChanged,
 		batchSize:       q.GetBatchSize(),
 		limit:           q.GetLimit(),
 		offset:          q.GetOffset(),
 		schema:          q.schema,
+		deleted:         q.deleted,
+		cursor:          q.cursor,
+		page:            q.page,
+		pageBefore:      q.pageBefore,
+		groupBy:         q.groupBy[:len(q.groupBy):len(q.groupBy)],
+		having:          q.having[:len(q.having):len(q.having)],
+		lock:            q.lock.copy(),
 	}
 }
 
@@ -150,6 +192,54 @@ func (q *BaseQuery) getRelationships() []Relationship {
 	return q.relationships
 }
 
+func (q *BaseQuery) getConditions(dialect Dialect) []ToSqler {
+	schema := withDialect(q.schema, dialect)
+	var conds = make([]ToSqler, 0, len(q.conditions)+1)
+	for _, cond := range q.conditions {
+		conds = append(conds, cond(schema))
+	}
+
+	if cond := q.deletedCondition(); cond != nil {
+		conds = append(conds, cond)
+	}
+
+	if q.page != "" {
+		conds = append(conds, pageCondition(q.keyset(), q.page, q.pageBefore)(schema))
+	}
+	return conds
+}
+
+// WithDeleted makes the query retrieve the soft deleted rows as well, which
+// are excluded by default. It has no effect if the schema of the query has no
+// soft delete.
+func (q *BaseQuery) WithDeleted() {
+	q.deleted = includeDeleted
+}
+
+// OnlyDeleted makes the query retrieve only the soft deleted rows. It has no
+// effect if the schema of the query has no soft delete.
+func (q *BaseQuery) OnlyDeleted() {
+	q.deleted = onlyDeleted
+}
+
+// deletedCondition returns the condition to filter the rows according to the
+// soft delete scope of the query, or nil if no filter is needed.
+func (q *BaseQuery) deletedCondition() ToSqler {
+	col := q.schema.softDeleteColumn()
+	if col == nil {
+		return nil
+	}
+
+	switch q.deleted {
+	case excludeDeleted:
+		return squirrel.Eq{col.QualifiedName(q.schema): nil}
+	case onlyDeleted:
+		return squirrel.NotEq{col.QualifiedName(q.schema): nil}
+	}
+
+	return nil
+}
+
 func (q *BaseQuery) selectedColumns() []SchemaField {
 	var result = make([]SchemaField, 0, len(q.columns))
 	for _, col := range q.columns {
@@ -193,12 +283,16 @@ func (q *BaseQuery) join(schema Schema, fk *ForeignKey) {
 		idCol = fk.QualifiedName(q.schema)
 	}
 
+	on := fmt.Sprintf("%s = %s", fkCol, idCol)
+	if col := schema.softDeleteColumn(); col != nil {
+		on += fmt.Sprintf(" AND %s IS NULL", col.QualifiedName(schema))
+	}
+
 	q.builder = q.builder.LeftJoin(fmt.Sprintf(
-		"%s %s ON (%s = %s)",
+		"%s %s ON (%s)",
 		schema.Table(),
 		schema.Alias(),
-		fkCol,
-		idCol,
+		on,
 	))
 
 	for _, col := range schema.Columns() {
@@ -212,11 +306,72 @@ func (q *BaseQuery) join(schema Schema, fk *ForeignKey) {
 // Order adds the given order clauses to the list of columns to order the
 // results by.
 func (q *BaseQuery) Order(cols ...ColumnOrder) {
-	var c = make([]string, len(cols))
-	for i, v := range cols {
-		c[i] = v.ToSql(q.schema)
+	q.orders = append(q.orders, cols...)
+}
+
+// GroupBy adds the given fields to the list of fields to group the rows by
+// when the query is aggregated with Store.Aggregate. It has no effect on
+// the rest of operations.
+func (q *BaseQuery) GroupBy(fields ...SchemaField) {
+	q.groupBy = append(q.groupBy, fields...)
+}
+
+// Having adds a new condition to filter the groups of rows when the query is
+// aggregated with Store.Aggregate. All conditions added are concatenated with
+// "and". Aggregates can be used in the conditions as any other field.
+//   q.Having(Gt(Sum(PriceColumn), 100))
+//   // ... HAVING SUM(price) > 100
+func (q *BaseQuery) Having(cond Condition) {
+	q.having = append(q.having, cond)
+}
+
+// After makes the query retrieve only the rows following the row of the
+// given page cursor in the order of the query, which must be the same as the
+// one of the query the cursor was returned by.
+func (q *BaseQuery) After(cursor PageCursor) {
+	q.page = cursor
+	q.pageBefore = false
+}
+
+// Before makes the query retrieve only the rows preceding the row of the
+// given page cursor in the order of the query, which must be the same as the
+// one of the query the cursor was returned by. If the query has a limit, the
+// rows closest to the cursor are retrieved.
+func (q *BaseQuery) Before(cursor PageCursor) {
+	q.page = cursor
+	q.pageBefore = true
+}
+
+// keyset returns the columns that determine the position of a row in the
+// order of the query, which are the columns it is ordered by followed by the
+// primary key, unless it is one of them already. Returns nil if the query is
+// not ordered nor paginated with a cursor. The query is only ordered by the
+// primary key as well if it is paginated or retrieved in batches.
+func (q *BaseQuery) keyset() []keysetColumn {
+	if len(q.orders) == 0 && q.page == "" {
+		return nil
+	}
+
+	var keyset = make([]keysetColumn, 0, len(q.orders)+1)
+	var hasID bool
+	for _, o := range q.orders {
+		o := o.(*colOrder)
+		isID := o.col.String() == q.schema.ID().String()
+		keyset = append(keyset, keysetColumn{col: o.col, desc: o.order == desc, notNull: isID})
+		hasID = hasID || isID
 	}
-	q.builder = q.builder.OrderBy(c...)
+
+	if !hasID {
+		keyset = append(keyset, keysetColumn{col: q.schema.ID(), notNull: true})
+	}
+	return keyset
+}
+
+// isReversed reports whether the rows of the query are retrieved in reverse
+// order, which happens when the rows closest to the cursor of Before are
+// limited. The rows must be reversed back after retrieving them.
+func (q *BaseQuery) isReversed() bool {
+	return q.pageBefore && q.page != "" && q.limit > 0
 }
 
 // BatchSize sets the batch size.
@@ -225,11 +380,64 @@ func (q *BaseQuery) BatchSize(size uint64) {
 }
 
 // GetBatchSize returns the number of rows retrieved per batch while retrieving
-// 1:N relationships.
+// 1:N relationships or fetching them from a cursor.
 func (q *BaseQuery) GetBatchSize() uint64 {
 	return q.batchSize
 }
 
+// UseCursor makes the store stream the rows of the query from a server-side
+// cursor, which is declared in a transaction and fetched in batches of the
+// batch size of the query.
+func (q *BaseQuery) UseCursor() {
+	q.cursor = true
+}
+
+func (q *BaseQuery) usesCursor() bool {
+	return q.cursor
+}
+
+// ForUpdate makes the query lock the rows it selects with FOR UPDATE, so
+// they can not be updated, deleted or locked by other transactions until the
+// transaction of the query ends. The query must be run in a transaction.
+func (q *BaseQuery) ForUpdate() {
+	q.lock.strength = "UPDATE"
+}
+
+// ForShare makes the query lock the rows it selects with FOR SHARE, so they
+// can not be updated, deleted or locked for update by other transactions
+// until the transaction of the query ends. The query must be run in a
+// transaction.
+func (q *BaseQuery) ForShare() {
+	q.lock.strength = "SHARE"
+}
+
+// SkipLocked makes the query skip the rows that are already locked by other
+// transactions instead of waiting for them, e.g. to implement work queues. It
+// locks the rows for update, unless ForShare is used.
+func (q *BaseQuery) SkipLocked() {
+	q.lock.wait = "SKIP LOCKED"
+}
+
+// NoWait makes the query fail if any of the rows it selects is already
+// locked by another transaction instead of waiting for it. It locks the rows
+// for update, unless ForShare is used.
+func (q *BaseQuery) NoWait() {
+	q.lock.wait = "NOWAIT"
+}
+
+// Of makes the query lock only the rows of the tables of the given schemas,
+// which must be the one of the query or its 1:1 relationships, otherwise the
+// query fails. By default, the rows of all of them are locked, which fails
+// for the 1:1 relationships that are not found, since they are retrieved with
+// outer joins. It locks the rows for update, unless ForShare is used.
+func (q *BaseQuery) Of(schemas ...Schema) {
+	q.lock.of = append(q.lock.of, schemas...)
+}
+
+func (q *BaseQuery) locksRows() bool {
+	return q.lock.isSet()
+}
+
 // Limit sets the max number of rows to retrieve.
 func (q *BaseQuery) Limit(n uint64) {
 	q.limit = n
@@ -256,11 +464,13 @@ func (q *BaseQuery) GetOffset() uint64 {
 //   q.Where(Gt(AgeColumn, 18))
 //   // ... WHERE name = "foo" AND age > 18
 func (q *BaseQuery) Where(cond Condition) {
-	q.builder = q.builder.Where(cond(q.schema))
+	q.conditions = append(q.conditions, cond)
 }
 
-// compile returns the selected column names and the select builder.
-func (q *BaseQuery) compile() ([]string, squirrel.SelectBuilder) {
+// compile returns the selected column names and the select builder for the
+// given dialect.
+func (q *BaseQuery) compile(dialect Dialect) ([]string, squirrel.SelectBuilder) {
+	schema := withDialect(q.schema, dialect)
 	columns := q.selectedColumns()
 	var (
 		qualifiedColumns = make([]string, len(columns))
@@ -268,18 +478,53 @@ func (q *BaseQuery) compile() ([]string, squirrel.SelectBuilder) {
 	)
 
 	for i := range columns {
-		qualifiedColumns[i] = columns[i].QualifiedName(q.schema)
+		qualifiedColumns[i] = columns[i].QualifiedName(schema)
 		columnNames[i] = columns[i].String()
 	}
-	return columnNames, q.builder.Columns(
+
+	builder := q.builder.PlaceholderFormat(dialect.PlaceholderFormat())
+	for _, cond := range q.getConditions(dialect) {
+		builder = builder.Where(cond)
+	}
+
+	// the primary key is only needed to break ties when paginating
+	if q.page != "" {
+		builder = builder.OrderBy(keysetOrders(schema, q.keyset(), q.isReversed())...)
+	} else if len(q.orders) > 0 {
+		var orders = make([]string, len(q.orders))
+		for i, o := range q.orders {
+			orders[i] = o.ToSql(schema)
+		}
+		builder = builder.OrderBy(orders...)
+	}
+
+	if q.lock.isSet() {
+		from := []Schema{q.schema}
+		for _, rel := range q.relationships {
+			if rel.Type == OneToOne {
+				from = append(from, rel.Schema)
+			}
+		}
+
+		lock, err := q.lock.toSql(from)
+		if err != nil {
+			// the error is returned when the query is built
+			builder = builder.Where(errSqler{err})
+		} else {
+			builder = builder.Suffix(lock)
+		}
+	}
+
+	return columnNames, builder.Columns(
 		append(qualifiedColumns, q.relationColumns...)...,
 	)
 }
 
-// String returns the SQL generated by the query. If the query is malformed,
-// it will return an empty string, as errors compiling the SQL are ignored.
+// String returns the SQL generated by the query for PostgreSQL. If the query
+// is malformed, it will return an empty string, as errors compiling the SQL
+// are ignored.
 func (q *BaseQuery) String() string {
-	_, builder := q.compile()
+	_, builder := q.compile(PostgreSQL)
 	sql, _, _ := builder.ToSql()
 	return sql
 }
@@ -49,6 +49,7 @@ func (s *QuerySuite) TestCopy() {
 	s.q.BatchSize(30)
 	s.q.Limit(2)
 	s.q.Offset(30)
+	s.q.Before("foo")
 	copy := s.q.Copy()
 
 	s.Equal(s.q, copy)
@@ -69,12 +70,57 @@ func (s *QuerySuite) TestOrder() {
 	s.assertSql("SELECT __model.foo FROM model __model ORDER BY __model.bar ASC, __model.baz DESC")
 }
 
+func (s *QuerySuite) TestAfter() {
+	cursor, err := encodePageCursor([]interface{}{int64(5), "foo"})
+	s.NoError(err)
+
+	s.q.Select(f("foo"))
+	s.q.Order(Desc(f("bar")))
+	s.q.After(cursor)
+
+	s.assertSql("SELECT __model.foo FROM model __model WHERE ((__model.bar < $1) OR (__model.bar = $2 AND __model.id > $3)) ORDER BY __model.bar DESC, __model.id ASC")
+	s.False(s.q.isReversed())
+}
+
+func (s *QuerySuite) TestAfter_Null() {
+	cursor, err := encodePageCursor([]interface{}{nil, "foo"})
+	s.NoError(err)
+
+	s.q.Select(f("foo"))
+	s.q.Order(Desc(f("bar")))
+	s.q.After(cursor)
+
+	s.assertSql("SELECT __model.foo FROM model __model WHERE ((__model.bar IS NOT NULL) OR (__model.bar IS NULL AND __model.id > $1)) ORDER BY __model.bar DESC, __model.id ASC")
+}
+
+func (s *QuerySuite) TestBefore() {
+	cursor, err := encodePageCursor([]interface{}{"foo"})
+	s.NoError(err)
+
+	s.q.Select(f("foo"))
+	s.q.Before(cursor)
+	s.assertSql("SELECT __model.foo FROM model __model WHERE ((__model.id < $1)) ORDER BY __model.id ASC")
+	s.False(s.q.isReversed())
+
+	s.q.Limit(10)
+	s.assertSql("SELECT __model.foo FROM model __model WHERE ((__model.id < $1)) ORDER BY __model.id DESC")
+	s.True(s.q.isReversed())
+}
+
+func (s *QuerySuite) TestAfter_Invalid() {
+	s.q.After("foo")
+	_, builder := s.q.compile(PostgreSQL)
+	_, _, err := builder.ToSql()
+	s.Equal(ErrInvalidPageCursor, err)
+}
+
 func (s *QuerySuite) TestWhere() {
 	s.q.Select(f("foo"))
 	s.q.Where(Eq(f("foo"), 5))
 	s.q.Where(Eq(f("bar"), "baz"))
 
 	s.assertSql("SELECT __model.foo FROM model __model WHERE __model.foo = $1 AND __model.bar = $2")
+	s.Len(s.q.getConditions(PostgreSQL), 2)
 }
 
 func (s *QuerySuite) TestString() {
@@ -101,8 +147,60 @@ func (s *QuerySuite) TestAddRelation_FKNotFound() {
 	s.Error(s.q.AddRelation(RelSchema, "fooo", OneToOne, nil))
 }
 
+func (s *QuerySuite) TestSoftDelete() {
+	q := NewBaseQuery(ModelSchema.WithSoftDelete(f("deleted_at")))
+	q.Select(f("foo"))
+	q.Where(Eq(f("foo"), 5))
+	s.Equal("SELECT __model.foo FROM model __model WHERE __model.foo = $1 AND __model.deleted_at IS NULL", q.String())
+	s.Len(q.getConditions(PostgreSQL), 2)
+
+	q.WithDeleted()
+	s.Equal("SELECT __model.foo FROM model __model WHERE __model.foo = $1", q.String())
+	s.Len(q.getConditions(PostgreSQL), 1)
+
+	q.OnlyDeleted()
+	s.Equal("SELECT __model.foo FROM model __model WHERE __model.foo = $1 AND __model.deleted_at IS NOT NULL", q.String())
+	s.Equal(q, q.Copy())
+}
+
+func (s *QuerySuite) TestAddRelation_SoftDelete() {
+	s.Nil(s.q.AddRelation(RelSchema.WithSoftDelete(f("deleted_at")), "rel", OneToOne, nil))
+	s.Equal("SELECT __model.id, __model.name, __model.email, __model.age, __rel_rel.id, __rel_rel.model_id, __rel_rel.foo FROM model __model LEFT JOIN rel __rel_rel ON (__rel_rel.model_id = __model.id AND __rel_rel.deleted_at IS NULL)", s.q.String())
+}
+
+func (s *QuerySuite) TestLock() {
+	s.q.Select(f("foo"))
+	s.False(s.q.locksRows())
+
+	s.q.ForUpdate()
+	s.True(s.q.locksRows())
+	s.assertSql("SELECT __model.foo FROM model __model FOR UPDATE")
+
+	s.q.Limit(1)
+	s.q.SkipLocked()
+	s.q.Of(ModelSchema)
+	copy := s.q.Copy()
+	s.assertSql("SELECT __model.foo FROM model __model FOR UPDATE OF __model SKIP LOCKED")
+
+	s.q.ForShare()
+	s.q.NoWait()
+	s.q.Of(RelSchema)
+	_, builder := s.q.compile(PostgreSQL)
+	_, _, err := builder.ToSql()
+	s.Error(err)
+
+	s.NoError(s.q.AddRelation(RelSchema, "rel", OneToOne, nil))
+	s.q.Select(f("foo"))
+	s.assertSql("SELECT __model.foo, __rel_rel.id, __rel_rel.model_id, __rel_rel.foo FROM model __model LEFT JOIN rel __rel_rel ON (__rel_rel.model_id = __model.id) FOR SHARE OF __model, __rel_rel NOWAIT")
+
+	_, builder = copy.compile(PostgreSQL)
+	sql, _, err := builder.Limit(1).ToSql()
+	s.NoError(err)
+	s.Equal("SELECT __model.foo FROM model __model LIMIT 1 FOR UPDATE OF __model SKIP LOCKED", sql)
+}
+
 func (s *QuerySuite) assertSql(sql string) {
-	_, builder := s.q.compile()
+	_, builder := s.q.compile(PostgreSQL)
 	result, _, err := builder.ToSql()
 	s.Nil(err)
 	s.Equal(sql, result)
@@ -0,0 +1,272 @@
+package kallax
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryLogger is a logger of the SQL statements run by a store.
+type QueryLogger interface {
+	// LogQuery logs the given entry. It is called once the statement has
+	// been run or, in the case of queries, once its rows have been read.
+	LogQuery(ctx context.Context, entry *QueryLogEntry)
+}
+
+// QueryLoggerFunc is a function that implements the QueryLogger interface.
+type QueryLoggerFunc func(context.Context, *QueryLogEntry)
+
+// LogQuery calls the function with the given entry.
+func (f QueryLoggerFunc) LogQuery(ctx context.Context, entry *QueryLogEntry) {
+	f(ctx, entry)
+}
+
+// QueryLogEntry is the log entry of a SQL statement.
+type QueryLogEntry struct {
+	// Kind is the kind of the statement.
+	Kind StatementKind
+	// Operation is the store operation the statement was run for.
+	Operation Operation
+	// Table is the table of the operation, if any.
+	Table string
+	// SQL is the SQL query of the statement.
+	SQL string
+	// Args are the arguments of the query, with the ones of the redacted
+	// columns replaced.
+	Args []interface{}
+	// Duration is the time it took to run the statement, not including the
+	// time spent reading the rows.
+	Duration time.Duration
+	// RowsAffected is the number of rows affected by an Exec statement, or
+	// -1 if it is not known.
+	RowsAffected int64
+	// RowsReturned is the number of rows read from a query, or -1 if it is
+	// not known.
+	RowsReturned int64
+	// Err is the error running the statement or reading its rows, if any.
+	Err error
+}
+
+// QueryLogOptions are the options to log the SQL statements of a store.
+type QueryLogOptions struct {
+	// SlowThreshold is the minimum duration a statement must take to be
+	// logged. If it is zero, all statements are logged.
+	SlowThreshold time.Duration
+	// RedactedColumns are the names of the columns whose values will be
+	// replaced by RedactedArg in the logged arguments. Columns are matched
+	// with the placeholders of the query they are inserted, assigned or
+	// compared to, so, if any column is given, the arguments that can not be
+	// matched with a column, such as the ones of tuples, subqueries or
+	// function calls, are redacted too.
+	RedactedColumns []string
+}
+
+// RedactedArg is the value logged instead of the arguments of the redacted
+// columns.
+const RedactedArg = "[REDACTED]"
+
+// NewQueryLogInterceptor returns an interceptor that logs all the statements
+// it intercepts with the given logger and options, except the prepares of
+// the statements, which are logged when they are run.
+func NewQueryLogInterceptor(logger QueryLogger, opts QueryLogOptions) Interceptor {
+	redacted := make(map[string]bool, len(opts.RedactedColumns))
+	for _, col := range opts.RedactedColumns {
+		redacted[strings.ToLower(col)] = true
+	}
+
+	log := func(ctx context.Context, entry *QueryLogEntry) {
+		if entry.Duration < opts.SlowThreshold {
+			return
+		}
+
+		entry.Args = redactArgs(entry.SQL, entry.Args, redacted)
+		logger.LogQuery(ctx, entry)
+	}
+
+	return func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+		// the prepared statements are logged when they are run
+		if stmt.Kind == PrepareStatement {
+			return next(ctx, stmt)
+		}
+
+		res := next(ctx, stmt)
+		entry := &QueryLogEntry{
+			Kind:         stmt.Kind,
+			Operation:    stmt.Operation,
+			Table:        stmt.Table,
+			SQL:          stmt.SQL,
+			Args:         stmt.Args,
+			Duration:     res.Duration,
+			RowsAffected: -1,
+			RowsReturned: -1,
+			Err:          res.Err,
+		}
+
+		if res.Err == nil {
+			switch stmt.Kind {
+			case ExecStatement:
+				if res.Result != nil {
+					if n, err := res.Result.RowsAffected(); err == nil {
+						entry.RowsAffected = n
+					}
+				}
+			case QueryStatement, QueryRowStatement:
+				logged := stmt.OnRowsClosed(func(rows int64, err error) {
+					entry.RowsReturned = rows
+					entry.Err = err
+					log(ctx, entry)
+				})
+				if logged {
+					return res
+				}
+			}
+		}
+
+		log(ctx, entry)
+		return res
+	}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *Store) WithQueryLogger(logger QueryLogger, opts QueryLogOptions) *Store {
+	return s.WithInterceptors(NewQueryLogInterceptor(logger, opts))
+}
+
+const identifier = `[A-Za-z_"` + "`" + `][\w"` + "`" + `]*(?:\.[A-Za-z_"` + "`" + `][\w"` + "`" + `]*)?`
+
+var (
+	insertColumnsRegexp = regexp.MustCompile(`(?is)^\s*INSERT\s+INTO\s+\S+\s*\(([^)]*)\)\s*VALUES\s*(.*)$`)
+	valuesTupleRegexp   = regexp.MustCompile(`\(([^()]*)\)`)
+	// comparisonRegexp and inRegexp only match the columns preceded by
+	// whitespace, a parenthesis or a comma, so the ones of casts, JSON
+	// paths or function calls are not taken as the compared column.
+	comparisonRegexp   = regexp.MustCompile(`(?i)(?:^|[\s(,])(` + identifier + `)\s*(?:=|<>|!=|<=|>=|<|>|\b(?:NOT\s+)?I?LIKE\b)\s*\$(\d+)`)
+	inRegexp           = regexp.MustCompile(`(?i)(?:^|[\s(,])(` + identifier + `)\s+(?:NOT\s+)?IN\s*\((\s*\$\d+(?:\s*,\s*\$\d+)*\s*)\)`)
+	placeholderRegexp  = regexp.MustCompile(`^\s*\$(\d+)\s*$`)
+	placeholdersRegexp = regexp.MustCompile(`\$(\d+)`)
+)
+
+// redactArgs returns a copy of the given arguments of the query with the
+// ones of the redacted columns, as well as the ones that can not be matched
+// with any column, replaced by RedactedArg.
+func redactArgs(query string, args []interface{}, redacted map[string]bool) []interface{} {
+	if len(redacted) == 0 || len(args) == 0 {
+		return args
+	}
+
+	columns := placeholderColumns(query)
+	var result []interface{}
+	for i := range args {
+		if col, ok := columns[i+1]; ok && !redacted[col] {
+			continue
+		}
+
+		if result == nil {
+			result = make([]interface{}, len(args))
+			copy(result, args)
+		}
+		result[i] = RedactedArg
+	}
+
+	if result == nil {
+		return args
+	}
+	return result
+}
+
+// placeholderColumns returns the columns the placeholders of the given query
+// are inserted, assigned or compared to, indexed by the number of the
+// placeholder. Positional placeholders are numbered by their position in the
+// query. Placeholders matched with several different columns are left out.
+func placeholderColumns(query string) map[int]string {
+	query = numberPlaceholders(query)
+	columns := make(map[int]string)
+	conflicts := make(map[int]bool)
+	add := func(placeholder, column string) {
+		n, err := strconv.Atoi(placeholder)
+		if err != nil {
+			return
+		}
+
+		column = columnName(column)
+		if c, ok := columns[n]; (ok && c != column) || conflicts[n] {
+			delete(columns, n)
+			conflicts[n] = true
+			return
+		}
+		columns[n] = column
+	}
+
+	if m := insertColumnsRegexp.FindStringSubmatch(query); m != nil {
+		cols := strings.Split(m[1], ",")
+		for _, tuple := range valuesTupleRegexp.FindAllStringSubmatch(m[2], -1) {
+			for i, value := range strings.Split(tuple[1], ",") {
+				if i >= len(cols) {
+					break
+				}
+
+				if p := placeholderRegexp.FindStringSubmatch(value); p != nil {
+					add(p[1], cols[i])
+				}
+			}
+		}
+	}
+
+	for _, m := range comparisonRegexp.FindAllStringSubmatch(query, -1) {
+		add(m[2], m[1])
+	}
+
+	for _, m := range inRegexp.FindAllStringSubmatch(query, -1) {
+		for _, p := range placeholdersRegexp.FindAllStringSubmatch(m[2], -1) {
+			add(p[1], m[1])
+		}
+	}
+
+	return columns
+}
+
+// numberPlaceholders returns the given query with its positional ?
+// placeholders, which are used by MySQL and SQLite, replaced by numbered ones.
+// The query is returned as is if it already has numbered placeholders, and
+// question marks inside quotes are left untouched.
+func numberPlaceholders(query string) string {
+	if !strings.Contains(query, "?") || placeholdersRegexp.MatchString(query) {
+		return query
+	}
+
+	var (
+		buf   bytes.Buffer
+		quote rune
+		n     int
+	)
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '?':
+			n++
+			buf.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// columnName returns the name of the given column of a query, without its
+// table and quotes and in lower case.
+func columnName(column string) string {
+	column = strings.TrimSpace(column)
+	if idx := strings.LastIndex(column, "."); idx >= 0 {
+		column = column[idx+1:]
+	}
+	return strings.ToLower(strings.Trim(column, "\"`"))
+}
@@ -0,0 +1,210 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceholderColumns(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected map[int]string
+	}{
+		{
+			`INSERT INTO model (name,email,age) VALUES ($1,$2,$3),($4,$5,$6) RETURNING "id"`,
+			map[int]string{1: "name", 2: "email", 3: "age", 4: "name", 5: "email", 6: "age"},
+		},
+		{
+			`UPDATE model SET name = $1, "email" = $2 WHERE id = $3`,
+			map[int]string{1: "name", 2: "email", 3: "id"},
+		},
+		{
+			`SELECT __model.id FROM model __model WHERE __model.email <> $1 AND __model.age IN ($2,$3) AND __model.name LIKE $4`,
+			map[int]string{1: "email", 2: "age", 3: "age", 4: "name"},
+		},
+		{
+			"INSERT INTO `model` (`name`,`email`,`age`) VALUES (?,?,?)",
+			map[int]string{1: "name", 2: "email", 3: "age"},
+		},
+		{
+			"UPDATE `model` SET `name` = ?, `email` = ? WHERE `id` = ? AND `name` <> '?'",
+			map[int]string{1: "name", 2: "email", 3: "id"},
+		},
+		{
+			`SELECT __model.id FROM model __model WHERE __model.email <> ? AND __model.age IN (?,?) AND __model.name LIKE ?`,
+			map[int]string{1: "email", 2: "age", 3: "age", 4: "name"},
+		},
+		{
+			`SELECT __model.id FROM model __model WHERE __model.email NOT LIKE $1 AND lower(__model.name) = $2 AND __model.email::text = $3 AND (__model.age, __model.id) > ($4, $5) AND __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo ILIKE $6)`,
+			map[int]string{1: "email", 6: "foo"},
+		},
+		{
+			`SELECT __model.id FROM model __model WHERE __model.name = $1 OR __model.email = $1`,
+			map[int]string{},
+		},
+		{
+			`SELECT 1`,
+			map[int]string{},
+		},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, placeholderColumns(c.query), c.query)
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	require := require.New(t)
+
+	query := `UPDATE model SET name = $1, "email" = $2 WHERE id = $3`
+	args := []interface{}{"Joe", "joe@foo.bar", 1}
+	redacted := map[string]bool{"email": true}
+
+	require.Equal(
+		[]interface{}{"Joe", RedactedArg, 1},
+		redactArgs(query, args, redacted),
+	)
+	require.Equal([]interface{}{"Joe", "joe@foo.bar", 1}, args)
+	require.Equal(args, redactArgs(query, args, nil))
+	require.Equal(args, redactArgs(query, args, map[string]bool{"foo": true}))
+}
+
+func TestRedactArgs_Unmatched(t *testing.T) {
+	require := require.New(t)
+
+	query := `SELECT __model.id FROM model __model WHERE __model.name = $1 AND lower(__model.email) = $2 AND (__model.age, __model.id) > ($3, $4)`
+	args := []interface{}{"Joe", "joe@foo.bar", 1, 2}
+
+	require.Equal(
+		[]interface{}{"Joe", RedactedArg, RedactedArg, RedactedArg},
+		redactArgs(query, args, map[string]bool{"foo": true}),
+	)
+	require.Equal(
+		[]interface{}{RedactedArg, RedactedArg, RedactedArg, RedactedArg},
+		redactArgs(query, args, map[string]bool{"name": true}),
+	)
+	require.Equal(args, redactArgs(query, args, nil))
+}
+
+func TestRedactArgs_Dialects(t *testing.T) {
+	args := []interface{}{"Joe", "joe@foo.bar", int64(1)}
+	redacted := map[string]bool{"email": true}
+
+	for _, dialect := range []Dialect{MySQL, SQLite} {
+		schema := withDialect(ModelSchema, dialect)
+		query, _, err := squirrel.Update(schema.Table()).
+			Set(dialect.QuoteIdentifier("name"), args[0]).
+			Set(dialect.QuoteIdentifier("email"), args[1]).
+			Where(Eq(f("id"), args[2])(schema)).
+			PlaceholderFormat(dialect.PlaceholderFormat()).
+			ToSql()
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]interface{}{"Joe", RedactedArg, int64(1)},
+			redactArgs(query, args, redacted),
+			dialect.Name(),
+		)
+	}
+}
+
+func TestQueryLogInterceptor(t *testing.T) {
+	require := require.New(t)
+
+	var entries []QueryLogEntry
+	logger := QueryLoggerFunc(func(_ context.Context, entry *QueryLogEntry) {
+		entries = append(entries, *entry)
+	})
+
+	fake := &fakeProxy{}
+	proxy := &interceptorProxy{
+		[]Interceptor{NewQueryLogInterceptor(logger, QueryLogOptions{
+			RedactedColumns: []string{"Email"},
+		})},
+		fake,
+	}
+
+	ctx := withOperation(context.Background(), UpdateOperation, "model")
+	_, err := proxy.ExecContext(ctx, `UPDATE model SET email = $1`, "foo")
+	require.NoError(err)
+	require.Len(entries, 1)
+	require.Equal(QueryLogEntry{
+		Kind:         ExecStatement,
+		Operation:    UpdateOperation,
+		Table:        "model",
+		SQL:          `UPDATE model SET email = $1`,
+		Args:         []interface{}{RedactedArg},
+		Duration:     entries[0].Duration,
+		RowsAffected: -1,
+		RowsReturned: -1,
+	}, entries[0])
+
+	_, err = proxy.Query(`SELECT 1`)
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.Equal(int64(-1), entries[1].RowsReturned)
+
+	ctx, hooks := withRowsHooks(context.Background())
+	_, err = proxy.QueryContext(ctx, `SELECT 2`)
+	require.NoError(err)
+	require.Len(entries, 2)
+	hooks.closed(3, errors.New("foo"))
+	require.Len(entries, 3)
+	require.Equal(`SELECT 2`, entries[2].SQL)
+	require.Equal(int64(3), entries[2].RowsReturned)
+	require.EqualError(entries[2].Err, "foo")
+
+	require.Equal(sql.ErrNoRows, proxy.QueryRow(`SELECT 3`).Scan())
+	require.Len(entries, 4)
+	require.Equal(int64(0), entries[3].RowsReturned)
+
+	fake.err = errors.New("bar")
+	_, err = proxy.QueryContext(ctx, `SELECT 4`)
+	require.Error(err)
+	require.Len(entries, 5)
+	require.EqualError(entries[4].Err, "bar")
+
+	// prepares are not logged
+	_, err = proxy.Prepare(`SELECT 5`)
+	require.Error(err)
+	require.Len(entries, 5)
+}
+
+func TestQueryLogInterceptor_SlowThreshold(t *testing.T) {
+	require := require.New(t)
+
+	var entries []QueryLogEntry
+	logger := QueryLoggerFunc(func(_ context.Context, entry *QueryLogEntry) {
+		entries = append(entries, *entry)
+	})
+
+	slow := func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+		res := next(ctx, stmt)
+		if stmt.SQL == "slow" {
+			res.Duration = time.Second
+		}
+		return res
+	}
+
+	proxy := &interceptorProxy{
+		[]Interceptor{
+			NewQueryLogInterceptor(logger, QueryLogOptions{SlowThreshold: 100 * time.Millisecond}),
+			slow,
+		},
+		&fakeProxy{},
+	}
+
+	_, err := proxy.Exec("fast")
+	require.NoError(err)
+	_, err = proxy.Exec("slow")
+	require.NoError(err)
+
+	require.Len(entries, 1)
+	require.Equal("slow", entries[0].SQL)
+}
@@ -20,6 +20,15 @@ type ResultSet interface {
 	io.Closer
 }
 
+// PageCursorResultSet is a result set that returns the page cursor of the
+// records it retrieves, which are all the result sets returned by Find.
+type PageCursorResultSet interface {
+	ResultSet
+	// Cursor returns the page cursor of the last record retrieved, or an
+	// empty one if no record has been retrieved yet.
+	Cursor() (PageCursor, error)
+}
+
 // ErrRawScan is an error returned when a the `Scan` method of `ResultSet`
 // is called with a `ResultSet` created as a result of a `RawQuery`, which is
 // not allowed.
@@ -29,26 +38,94 @@ var ErrRawScan = errors.New("kallax: result set comes from raw query, use RawSca
 // with a batching result set.
 var ErrRawScanBatching = errors.New("kallax: cannot perform a raw scan on a batching result set")
 
-// BaseResultSet is a generic collection of rows.
+// resultRows are the rows read by a result set, which are *sql.Rows unless
+// they are read natively by the driver of the store.
+type resultRows interface {
+	Next() bool
+	Scan(...interface{}) error
+	Columns() ([]string, error)
+	Err() error
+	Close() error
+}
+
+// BaseResultSet is a generic collection of rows, which are read with
+// database/sql or natively by the driver of the store, as in the stores
+// created with NewStoreWithNativeDriver.
 type BaseResultSet struct {
 	relationships []Relationship
 	columns       []string
 	readOnly      bool
+	// Rows are the rows of the result set when they are read with
+	// database/sql. They are nil if the rows are read natively by the driver
+	// of the store.
 	*sql.Rows
+	rows resultRows
+	// read is the number of rows read so far.
+	read int64
+	// scanned is the number of rows scanned into records so far.
+	scanned int64
+	// hooks are called with the number of rows read once the result set is
+	// closed.
+	hooks *rowsHooks
+	// dialect is the dialect of the database the rows come from, if any.
+	dialect Dialect
+	// keyset are the columns that determine the position of the rows in the
+	// order of the query, if it is ordered.
+	keyset []keysetColumn
+	// last is the last record scanned.
+	last Record
 }
 
 // NewResultSet creates a new result set with the given rows and columns.
 // It is mandatory that all column names are in the same order and are exactly
 // equal to the ones in the query that produced the rows.
 func NewResultSet(rows *sql.Rows, readOnly bool, relationships []Relationship, columns ...string) *BaseResultSet {
+	return newResultSet(rows, readOnly, relationships, columns...)
+}
+
+// newResultSet creates a new result set with the given rows, which are not
+// necessarily *sql.Rows. See NewResultSet.
+func newResultSet(rows resultRows, readOnly bool, relationships []Relationship, columns ...string) *BaseResultSet {
+	sqlRows, _ := rows.(*sql.Rows)
 	return &BaseResultSet{
-		relationships,
-		columns,
-		readOnly,
-		rows,
+		relationships: relationships,
+		columns:       columns,
+		readOnly:      readOnly,
+		Rows:          sqlRows,
+		rows:          rows,
 	}
 }
 
+// Next prepares the next row for reading and returns false if there are no
+// more rows.
+func (rs *BaseResultSet) Next() bool {
+	if !rs.rows.Next() {
+		return false
+	}
+
+	rs.read++
+	return true
+}
+
+// Err returns the error, if any, that was encountered while reading the
+// rows.
+func (rs *BaseResultSet) Err() error {
+	return rs.rows.Err()
+}
+
+// Columns returns the names of the columns of the rows.
+func (rs *BaseResultSet) Columns() ([]string, error) {
+	return rs.rows.Columns()
+}
+
+// Close closes the result set, preventing further reading.
+func (rs *BaseResultSet) Close() error {
+	err := rs.rows.Err()
+	closeErr := rs.rows.Close()
+	rs.hooks.closed(rs.read, err)
+	return closeErr
+}
+
 // Get returns the next record in the schema.
 func (rs *BaseResultSet) Get(schema Schema) (Record, error) {
 	record := schema.New()
@@ -75,7 +152,7 @@ func (rs *BaseResultSet) Scan(record Record) error {
 			return err
 		}
 
-		pointers[i] = ptr
+		pointers[i] = rs.nativeColumn(rs.column(ptr))
 	}
 
 	for i, r := range rs.relationships {
@@ -89,13 +166,13 @@ func (rs *BaseResultSet) Scan(record Record) error {
 			if err != nil {
 				return err
 			}
-			pointers = append(pointers, types.Nullable(ptr))
+			pointers = append(pointers, types.Nullable(rs.column(ptr)))
 		}
 
 		relationships[i] = rec
 	}
 
-	if err := rs.Rows.Scan(pointers...); err != nil {
+	if err := rs.rows.Scan(pointers...); err != nil {
 		return err
 	}
 
@@ -110,14 +187,43 @@ func (rs *BaseResultSet) Scan(record Record) error {
 
 	record.setWritable(!rs.readOnly)
 	record.setPersisted()
+	rs.scanned++
+	rs.last = record
 	return nil
 }
 
+// Cursor returns the page cursor of the last record scanned, or an empty
+// one if no record has been scanned yet. ErrPageCursorNotSupported is
+// returned if the query is not ordered or does not select the columns it is
+// ordered by and the primary key.
+func (rs *BaseResultSet) Cursor() (PageCursor, error) {
+	return pageCursorOf(rs.last, rs.keyset, rs.columns)
+}
+
+// column returns the given column address converted to the way the dialect
+// of the result set stores it.
+func (rs *BaseResultSet) column(ptr interface{}) interface{} {
+	if rs.dialect == nil {
+		return ptr
+	}
+	return rs.dialect.column(ptr)
+}
+
+// nativeColumn returns the given column address converted to the one the
+// rows of the result set decode natively, if they do. Columns of
+// relationships are not converted, since they are always nullable.
+func (rs *BaseResultSet) nativeColumn(ptr interface{}) interface{} {
+	if r, ok := rs.rows.(NativeRows); ok {
+		return r.Column(ptr)
+	}
+	return ptr
+}
+
 // RowScan copies the columns in the current row into the values pointed at by
 // dest. The number of values in dest must be the same as the number of columns
 // selected in the query.
 func (rs *BaseResultSet) RawScan(dest ...interface{}) error {
-	return rs.Rows.Scan(dest...)
+	return rs.rows.Scan(dest...)
 }
 
 // NewBatchingResultSet returns a new result set that performs batching
@@ -162,10 +268,17 @@ func (rs *BatchingResultSet) Get(_ Schema) (Record, error) {
 	return rs.last, rs.lastErr
 }
 
-// Close will do nothing, as the internal result sets used by this are closed
-// when the rows at fetched. It will never throw an error.
+// Cursor returns the page cursor of the last record retrieved, or an empty
+// one if no record has been retrieved yet. See BaseResultSet.Cursor.
+func (rs *BatchingResultSet) Cursor() (PageCursor, error) {
+	return pageCursorOf(rs.last, rs.runner.keyset, rs.runner.cols)
+}
+
+// Close closes the cursor the rows are fetched from, if the query uses one.
+// Otherwise, it will do nothing, as the internal result sets used by this are
+// closed when the rows are fetched, and it will never throw an error.
 func (rs *BatchingResultSet) Close() error {
-	return nil
+	return rs.runner.close()
 }
 
 // RawScan will always throw an error, as this is not a supported operation of
@@ -0,0 +1,22 @@
+package kallax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseResultSet_Rows(t *testing.T) {
+	require := require.New(t)
+
+	// the rows are not *sql.Rows, as the ones read natively with pgx
+	rs := newResultSet(fakeRows{}, false, nil, "id")
+	require.NoError(rs.Err())
+
+	columns, err := rs.Columns()
+	require.NoError(err)
+	require.Empty(columns)
+
+	require.False(rs.Next())
+	require.NoError(rs.Close())
+}
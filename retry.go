@@ -0,0 +1,121 @@
+package kallax
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// RetryPolicy defines how the transactions of a store are retried when they
+// fail with errors that are expected to succeed if the transaction is run
+// again, such as serialization failures or deadlocks.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of times a transaction is run, including
+	// the first one. Transactions are not retried if it is less than 2.
+	MaxAttempts int
+	// Backoff returns the time to wait before the given retry, starting
+	// from 1. If it is nil, transactions are retried right away.
+	Backoff func(retry int) time.Duration
+	// Retryable reports whether a transaction that failed with the given
+	// error can be retried. If it is nil, IsRetryableError is used.
+	Retryable func(error) bool
+	// OnRetry is called before every retry with the number of the retry,
+	// starting from 1, and the error that caused it. It is optional.
+	OnRetry func(retry int, err error)
+}
+
+// ExponentialBackoff returns a backoff function for RetryPolicy that waits
+// the given base duration before the first retry and doubles it on every
+// subsequent retry.
+func ExponentialBackoff(base time.Duration) func(int) time.Duration {
+	return func(retry int) time.Duration {
+		return base << uint(retry-1)
+	}
+}
+
+// run executes the given function until it succeeds, returns an error that
+// can not be retried or the max number of attempts is reached. Retries are
+// logged using the given logger, if any.
+func (p *RetryPolicy) run(ctx context.Context, logger LoggerFunc, fn func() error) error {
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsRetryableError
+	}
+
+	for retry := 1; ; retry++ {
+		err := fn()
+		if err == nil || retry >= p.MaxAttempts || !retryable(err) {
+			return err
+		}
+
+		if p.OnRetry != nil {
+			p.OnRetry(retry, err)
+		}
+
+		if logger != nil {
+			logger(fmt.Sprintf("kallax: retrying transaction (retry %d of %d): %s", retry, p.MaxAttempts-1, err))
+		}
+
+		if p.Backoff != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(p.Backoff(retry)):
+			}
+		}
+	}
+}
+
+const (
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// IsRetryableError reports whether the given error is a PostgreSQL
+// serialization failure or deadlock, which means the transaction that
+// returned it can be retried.
+func IsRetryableError(err error) bool {
+	code, ok := sqlState(err)
+	return ok && (code == serializationFailure || code == deadlockDetected)
+}
+
+// sqlState returns the SQLSTATE code of the given error, and whether it was
+// returned by the database through either lib/pq or pgx.
+func sqlState(err error) (string, bool) {
+	if c, ok := err.(causer); ok {
+		err = c.Cause()
+	}
+
+	switch e := err.(type) {
+	case *pq.Error:
+		return string(e.Code), true
+	case interface {
+		SQLState() string
+	}:
+		return e.SQLState(), true
+	}
+
+	return "", false
+}
+
+type causer interface {
+	Cause() error
+}
+
+// txError is an error that happened managing a transaction, which keeps
+// the error returned by the database as its cause.
+type txError struct {
+	msg   string
+	cause error
+}
+
+func (e *txError) Error() string {
+	return e.msg + ": " + e.cause.Error()
+}
+
+// Cause returns the error returned by the database.
+func (e *txError) Cause() error {
+	return e.cause
+}
@@ -0,0 +1,105 @@
+package kallax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyRun(t *testing.T) {
+	require := require.New(t)
+
+	var retries []int
+	var logged []string
+	p := &RetryPolicy{
+		MaxAttempts: 3,
+		OnRetry: func(retry int, err error) {
+			retries = append(retries, retry)
+		},
+	}
+	logger := func(message string, args ...interface{}) {
+		require.Empty(args)
+		logged = append(logged, message)
+	}
+
+	var calls int
+	err := p.run(context.Background(), logger, func() error {
+		calls++
+		return &pq.Error{Code: "40P01", Message: "deadlock detected"}
+	})
+	require.Equal(&pq.Error{Code: "40P01", Message: "deadlock detected"}, err)
+	require.Equal(3, calls)
+	require.Equal([]int{1, 2}, retries)
+	require.Equal([]string{
+		"kallax: retrying transaction (retry 1 of 2): pq: deadlock detected",
+		"kallax: retrying transaction (retry 2 of 2): pq: deadlock detected",
+	}, logged)
+
+	calls = 0
+	err = p.run(context.Background(), nil, func() error {
+		calls++
+		return errors.New("foo")
+	})
+	require.EqualError(err, "foo")
+	require.Equal(1, calls, "non retryable errors are not retried")
+
+	calls = 0
+	p.Retryable = func(error) bool { return true }
+	err = p.run(context.Background(), nil, func() error {
+		calls++
+		if calls < 2 {
+			return errors.New("foo")
+		}
+		return nil
+	})
+	require.NoError(err)
+	require.Equal(2, calls)
+}
+
+func TestRetryPolicyRun_Cancelled(t *testing.T) {
+	p := &RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ExponentialBackoff(time.Hour),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int
+	err := p.run(ctx, nil, func() error {
+		calls++
+		return &pq.Error{Code: "40001"}
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(10 * time.Millisecond)
+	require.Equal(t, 10*time.Millisecond, backoff(1))
+	require.Equal(t, 20*time.Millisecond, backoff(2))
+	require.Equal(t, 80*time.Millisecond, backoff(4))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{errors.New("foo"), false},
+		{&pq.Error{Code: "23505"}, false},
+		{&pq.Error{Code: "40001"}, true},
+		{&pq.Error{Code: "40P01"}, true},
+		{&txError{"kallax: unable to commit transaction", &pq.Error{Code: "40001"}}, true},
+		{fmt.Errorf("kallax: %s", &pq.Error{Code: "40001"}), false},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.retryable, IsRetryableError(c.err), "%v", c.err)
+	}
+}
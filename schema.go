@@ -1,9 +1,6 @@
 package kallax
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 // Schema represents a table schema in the database. Contains some information
 // like the table name, its columns, its identifier and so on.
@@ -26,6 +23,8 @@ type Schema interface {
 	// New creates a new record with the given schema.
 	New() Record
 	isPrimaryKeyAutoIncrementable() bool
+	versionColumn() SchemaField
+	softDeleteColumn() SchemaField
 }
 
 // BaseSchema is the basic implementation of Schema.
@@ -37,6 +36,8 @@ type BaseSchema struct {
 	columns     []SchemaField
 	constructor RecordConstructor
 	autoIncr    bool
+	version     SchemaField
+	softDelete  SchemaField
 }
 
 // RecordConstructor is a function that creates a record.
@@ -71,6 +72,26 @@ func (s *BaseSchema) New() Record {
 	return s.constructor()
 }
 func (s *BaseSchema) isPrimaryKeyAutoIncrementable() bool { return s.autoIncr }
+func (s *BaseSchema) versionColumn() SchemaField          { return s.version }
+func (s *BaseSchema) softDeleteColumn() SchemaField       { return s.softDelete }
+
+// WithVersion returns a copy of the schema using the given column as the
+// version of the records, which enables optimistic locking on updates.
+func (s *BaseSchema) WithVersion(col SchemaField) *BaseSchema {
+	schema := *s
+	schema.version = col
+	return &schema
+}
+
+// WithSoftDelete returns a copy of the schema using the given column to store
+// the time in which the records are deleted. Records of a schema with soft
+// delete are marked as deleted instead of being removed, and they are
+// excluded by default from the queries.
+func (s *BaseSchema) WithSoftDelete(col SchemaField) *BaseSchema {
+	schema := *s
+	schema.softDelete = col
+	return &schema
+}
 
 type aliasSchema struct {
 	*BaseSchema
@@ -183,22 +204,15 @@ func NewJSONSchemaKey(typ JSONKeyType, field string, paths ...string) *JSONSchem
 	return &JSONSchemaKey{typ, field, paths}
 }
 
+// QualifiedName returns the expression to retrieve the key in the dialect of
+// the given schema.
 func (f *JSONSchemaKey) QualifiedName(schema Schema) string {
-	op := "#>"
-	format := "%s%s %s'{%s}'"
-	if f.typ == JSONText {
-		op = "#>>"
-	} else if f.typ != JSONAny {
-		op = "#>>"
-		format = "CAST(%s%s %s'{%s}' as " + string(f.typ) + ")"
-	}
-
 	var alias string
 	if schema != nil && schema.Alias() != "" {
 		alias = schema.Alias() + "."
 	}
 
-	return fmt.Sprintf(format, alias, f.field, op, strings.Join(f.paths, ","))
+	return dialectOf(schema).JSONPath(alias+f.field, f.typ, f.paths...)
 }
 
 func (f *JSONSchemaKey) String() string {
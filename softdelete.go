@@ -0,0 +1,24 @@
+package kallax
+
+import "time"
+
+// SoftDelete contains the date in which the model was deleted. Models
+// embedding it are not removed from the database when they are deleted, they
+// are just marked as deleted instead, and they are excluded by default from
+// all the queries. It is intended to be embedded in the model.
+//
+//	type MyModel struct {
+//		kallax.Model
+//		kallax.SoftDelete
+//		Foo string
+//	}
+type SoftDelete struct {
+	// DeletedAt is the time where the object was deleted. It is nil if the
+	// object has not been deleted.
+	DeletedAt *time.Time `kallax:",softdelete"`
+}
+
+// IsDeleted reports whether the model has been deleted.
+func (d *SoftDelete) IsDeleted() bool {
+	return d.DeletedAt != nil
+}
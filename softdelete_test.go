@@ -0,0 +1,17 @@
+package kallax
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftDeleteIsDeleted(t *testing.T) {
+	var d SoftDelete
+	require.False(t, d.IsDeleted())
+
+	now := time.Now()
+	d.DeletedAt = &now
+	require.True(t, d.IsDeleted())
+}
@@ -1,10 +1,14 @@
 package kallax
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"reflect"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/lann/builder"
@@ -32,6 +36,20 @@ var (
 	// neither an autoincrement primary key nor implements the IDSetter
 	// interface.
 	ErrCantSetID = errors.New("kallax: model does not have an auto incrementable primary key, it needs to implement IDSetter interface")
+	// ErrSetQueryNotSupported is returned when a query with relationships,
+	// limit or offset is used to update or delete a set of rows.
+	ErrSetQueryNotSupported = errors.New("kallax: queries with relationships, limit or offset can not be used to update or delete rows")
+	// ErrNoConflictTarget is returned when an upsert that updates the
+	// conflicting row is performed without any conflict columns.
+	ErrNoConflictTarget = errors.New("kallax: upsert requires conflict columns to update the conflicting row")
+	// ErrStaleRecord is returned when a versioned record is updated but its
+	// version does not match the one in the database, meaning the row was
+	// modified or removed since the record was retrieved.
+	ErrStaleRecord = errors.New("kallax: record has been modified since it was retrieved")
+	// ErrTxOptionsInTransaction is returned when a transaction with options is
+	// started in a store that is already in a transaction, since the options
+	// of the running transaction can not be changed.
+	ErrTxOptionsInTransaction = errors.New("kallax: transaction options can not be used inside a transaction")
 )
 
 // GenericStorer is a type that contains a generic store and has methods to
@@ -56,10 +74,57 @@ func StoreFrom(to, from GenericStorer) {
 // logs it.
 type LoggerFunc func(string, ...interface{})
 
+// dbProxy is a database proxy that can run statements both with and without
+// a context.
+type dbProxy interface {
+	squirrel.DBProxyContext
+	squirrel.ExecerContext
+	squirrel.QueryerContext
+	squirrel.QueryRowerContext
+}
+
+// newStmtCacher returns a dbProxy wrapping the given database or transaction
+// that caches prepared statements, which are prepared through the
+// interceptors of the statements that need them.
+func newStmtCacher(prep squirrel.PreparerContext) dbProxy {
+	return squirrel.NewStmtCacher(interceptedPreparer{prep}).(dbProxy)
+}
+
+// rowsQueryer is implemented by the database proxies that can run queries
+// returning rows that are not necessarily *sql.Rows, such as the ones read
+// natively by the driver of the store.
+type rowsQueryer interface {
+	queryRows(ctx context.Context, query string, args []interface{}) (resultRows, error)
+}
+
+// queryRows runs the given query in the proxy and returns its rows, which
+// are read natively if the proxy supports it.
+func queryRows(ctx context.Context, proxy dbProxy, query string, args []interface{}) (resultRows, error) {
+	if q, ok := proxy.(rowsQueryer); ok {
+		return q.queryRows(ctx, query, args)
+	}
+
+	rows, err := proxy.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// querySelect runs the query of the given builder in the proxy. See
+// queryRows.
+func querySelect(ctx context.Context, proxy dbProxy, builder squirrel.SelectBuilder) (resultRows, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return queryRows(ctx, proxy, query, args)
+}
+
 // debugProxy is a database proxy that logs all SQL statements executed.
 type debugProxy struct {
 	logger LoggerFunc
-	proxy  squirrel.DBProxy
+	proxy  dbProxy
 }
 
 func defaultLogger(message string, args ...interface{}) {
@@ -86,32 +151,124 @@ func (p *debugProxy) Prepare(query string) (*sql.Stmt, error) {
 	return p.proxy.Prepare(query)
 }
 
+func (p *debugProxy) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.logger(fmt.Sprintf("kallax: Exec: %s", query), args...)
+	return p.proxy.ExecContext(ctx, query, args...)
+}
+
+func (p *debugProxy) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	p.logger(fmt.Sprintf("kallax: Query: %s", query), args...)
+	return p.proxy.QueryContext(ctx, query, args...)
+}
+
+func (p *debugProxy) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	p.logger(fmt.Sprintf("kallax: QueryRow: %s", query), args...)
+	return p.proxy.QueryRowContext(ctx, query, args...)
+}
+
+func (p *debugProxy) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	p.logger(fmt.Sprintf("kallax: Prepare: %s", query))
+	return p.proxy.PrepareContext(ctx, query)
+}
+
+func (p *debugProxy) copyFrom(ctx context.Context, query string, src copySource) (int64, error) {
+	p.logger(fmt.Sprintf("kallax: Exec: %s", query))
+	return copyFrom(ctx, p.proxy, query, src)
+}
+
+func (p *debugProxy) copyTo(ctx context.Context, query string, w io.Writer) (int64, error) {
+	p.logger(fmt.Sprintf("kallax: Exec: %s", query))
+	return copyTo(ctx, p.proxy, query, w)
+}
+
+func (p *debugProxy) queryRows(ctx context.Context, query string, args []interface{}) (resultRows, error) {
+	p.logger(fmt.Sprintf("kallax: Query: %s", query), args...)
+	return queryRows(ctx, p.proxy, query, args)
+}
+
 // Store is a structure capable of retrieving records from a concrete table in
 // the database.
 type Store struct {
 	builder squirrel.StatementBuilderType
 	db      *sql.DB
-	proxy   squirrel.DBProxy
+	dialect Dialect
+	// runner is the proxy of the database or transaction of the store,
+	// which proxy wraps with the logger and interceptors, if any.
+	runner       dbProxy
+	proxy        dbProxy
+	logger       LoggerFunc
+	interceptors []Interceptor
+	// savepoints is the number of savepoints opened in the transaction of
+	// the store, used to name nested transactions.
+	savepoints int
+	retry      *RetryPolicy
+	tracer     Tracer
+	metrics    MetricsSink
 }
 
-// NewStore returns a new Store instance.
+// NewStore returns a new Store instance for a PostgreSQL database.
 func NewStore(db *sql.DB) *Store {
-	proxy := squirrel.NewStmtCacher(db)
-	builder := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(proxy)
-	return &Store{
+	return NewStoreWithDialect(db, PostgreSQL)
+}
+
+// NewStoreWithDialect returns a new Store instance for a database of the
+// given dialect.
+func NewStoreWithDialect(db *sql.DB, dialect Dialect) *Store {
+	s := &Store{
 		db:      db,
-		proxy:   proxy,
-		builder: builder,
+		dialect: dialect,
+		runner:  newStmtCacher(db),
 	}
+	return s.build()
 }
 
-func newStoreWithTransaction(tx *sql.Tx) *Store {
-	proxy := squirrel.NewStmtCacher(tx)
-	builder := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(proxy)
-	return &Store{
-		proxy:   proxy,
-		builder: builder,
+// newStoreWithTransaction returns a new store running its statements in the
+// given transaction, with the same dialect, logger, interceptors, tracer and
+// metrics sink as this one.
+func (s *Store) newStoreWithTransaction(tx *sql.Tx) *Store {
+	store := &Store{
+		dialect:      s.dialect,
+		runner:       &txProxy{newStmtCacher(tx), tx},
+		logger:       s.logger,
+		interceptors: s.interceptors,
+		tracer:       s.tracer,
+		metrics:      s.metrics,
 	}
+	return store.build()
+}
+
+// build sets up the proxy and the statement builder of the store.
+func (s *Store) build() *Store {
+	if s.dialect == nil {
+		s.dialect = PostgreSQL
+	}
+
+	s.proxy = s.runner
+	if s.logger != nil {
+		s.proxy = &debugProxy{s.logger, s.proxy}
+	}
+
+	interceptors := s.interceptors[:len(s.interceptors):len(s.interceptors)]
+	if s.metrics != nil {
+		interceptors = append(interceptors, metricsInterceptor(s.metrics))
+	}
+
+	if s.tracer != nil {
+		interceptors = append(interceptors, traceStatement)
+	}
+
+	if len(interceptors) > 0 {
+		s.proxy = &interceptorProxy{interceptors, s.proxy}
+	}
+
+	s.builder = squirrel.StatementBuilder.PlaceholderFormat(s.dialect.PlaceholderFormat()).RunWith(s.proxy)
+	return s
+}
+
+// copy returns a shallow copy of the store.
+func (s *Store) copy() *Store {
+	store := *s
+	return &store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
@@ -123,16 +280,44 @@ func (s *Store) Debug() *Store {
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
 func (s *Store) DebugWith(logger LoggerFunc) *Store {
-	return &Store{
-		builder: s.builder,
-		db:      s.db,
-		proxy:   &debugProxy{logger, s.proxy},
-	}
+	store := s.copy()
+	store.logger = logger
+	return store.build()
+}
+
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors, in order, after the ones the store already
+// had. Interceptors are kept in the stores passed to transaction callbacks.
+func (s *Store) WithInterceptors(interceptors ...Interceptor) *Store {
+	store := s.copy()
+	store.interceptors = append(s.interceptors[:len(s.interceptors):len(s.interceptors)], interceptors...)
+	return store.build()
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy, as long as they are not nested in another
+// transaction. The callback of a transaction can be run several times, so it
+// should have no side effects other than the operations on the store.
+// With the CockroachDB dialect, the policy is used to restart the
+// transactions inside the database instead of running them again.
+func (s *Store) WithRetry(policy RetryPolicy) *Store {
+	store := s.copy()
+	store.retry = &policy
+	return store
 }
 
 // Insert insert the given record in the table, returns error if no-new
 // record is given. The record id is set if it's empty.
 func (s *Store) Insert(schema Schema, record Record) error {
+	return s.InsertContext(context.Background(), schema, record)
+}
+
+// InsertContext inserts the given record in the table using the given
+// context. See Insert for more details.
+func (s *Store) InsertContext(ctx context.Context, schema Schema, record Record) (err error) {
+	ctx, span := s.startOperation(ctx, InsertOperation, schema.Table())
+	defer func() { span.Finish(err) }()
+
 	if record.IsPersisted() {
 		return ErrNonNewDocument
 	}
@@ -145,7 +330,7 @@ func (s *Store) Insert(schema Schema, record Record) error {
 		cols = cols[1:]
 	}
 
-	values, err := RecordValues(record, cols...)
+	values, err := s.recordValues(record, cols...)
 	if err != nil {
 		return err
 	}
@@ -161,12 +346,16 @@ func (s *Store) Insert(schema Schema, record Record) error {
 			return err
 		}
 
-		err = builder.
-			Suffix(fmt.Sprintf("RETURNING %q", schema.ID())).
-			QueryRow().
-			Scan(pk)
+		if s.dialect.supportsReturning() {
+			err = builder.
+				Suffix("RETURNING " + s.dialect.QuoteIdentifier(schema.ID().String())).
+				QueryRowContext(ctx).
+				Scan(pk)
+		} else {
+			err = s.execLastInsertID(ctx, builder, pk)
+		}
 	} else {
-		_, err = builder.Exec()
+		_, err = builder.ExecContext(ctx)
 	}
 
 	if err != nil {
@@ -178,11 +367,150 @@ func (s *Store) Insert(schema Schema, record Record) error {
 	return nil
 }
 
+// InsertMany inserts all the given records in the table using multi-row
+// inserts. Records are inserted in chunks small enough not to exceed the
+// maximum number of parameters allowed in a statement. If more than one
+// statement is needed, all of them are executed in a transaction.
+// Returns error if any of the records is not new. The record ids are set if
+// they are empty.
+func (s *Store) InsertMany(schema Schema, records ...Record) error {
+	return s.InsertManyContext(context.Background(), schema, records...)
+}
+
+// InsertManyContext inserts all the given records in the table using the
+// given context. See InsertMany for more details.
+func (s *Store) InsertManyContext(ctx context.Context, schema Schema, records ...Record) (err error) {
+	ctx, span := s.startOperation(ctx, InsertOperation, schema.Table())
+	defer func() { span.Finish(err) }()
+
+	for _, r := range records {
+		if r.IsPersisted() {
+			return ErrNonNewDocument
+		}
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	cols := ColumnNames(schema.Columns())
+	if schema.isPrimaryKeyAutoIncrementable() {
+		cols = cols[1:]
+	}
+
+	chunkSize := insertChunkSize(s.dialect, len(records), len(cols))
+	if chunkSize >= len(records) {
+		return s.insertMany(ctx, schema, cols, records)
+	}
+
+	return s.TransactionContext(ctx, func(store *Store) error {
+		for len(records) > 0 {
+			n := chunkSize
+			if n > len(records) {
+				n = len(records)
+			}
+
+			if err := store.insertMany(ctx, schema, cols, records[:n]); err != nil {
+				return err
+			}
+			records = records[n:]
+		}
+		return nil
+	})
+}
+
+// insertChunkSize returns how many of the given number of records, with the
+// given number of columns, can be inserted in a single statement without
+// exceeding the maximum number of parameters of the dialect.
+func insertChunkSize(dialect Dialect, records, cols int) int {
+	if max := dialect.maxParams(); cols > 0 && records*cols > max {
+		return max / cols
+	}
+	return records
+}
+
+// insertMany inserts the given records with the given columns in a single
+// statement.
+func (s *Store) insertMany(ctx context.Context, schema Schema, cols []string, records []Record) error {
+	builder := s.builder.
+		Insert(schema.Table()).
+		Columns(cols...)
+
+	for _, r := range records {
+		values, err := s.recordValues(r, cols...)
+		if err != nil {
+			return err
+		}
+		builder = builder.Values(values...)
+	}
+
+	if schema.isPrimaryKeyAutoIncrementable() && !s.dialect.ordersReturning() {
+		if err := s.insertManyLastInsertID(ctx, schema, builder, records); err != nil {
+			return err
+		}
+	} else if schema.isPrimaryKeyAutoIncrementable() {
+		rows, err := builder.
+			Suffix("RETURNING " + s.dialect.QuoteIdentifier(schema.ID().String())).
+			QueryContext(ctx)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		// rows are returned in the same order as they were given in the
+		// VALUES list.
+		var i int
+		for rows.Next() {
+			if i >= len(records) {
+				return errInsertedIDs(i+1, len(records))
+			}
+
+			pk, err := records[i].ColumnAddress(schema.ID().String())
+			if err != nil {
+				return err
+			}
+
+			if err := rows.Scan(pk); err != nil {
+				return err
+			}
+			i++
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if i != len(records) {
+			return errInsertedIDs(i, len(records))
+		}
+	} else if _, err := builder.ExecContext(ctx); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		r.setWritable(true)
+		r.setPersisted()
+	}
+	return nil
+}
+
 // Update updates the given fields of a record in the table. All fields are
 // updated if no fields are provided. For an update to take place, the record is
 // required to have a non-empty ID and not to be a new record.
+// If the schema has a version column, the row is only updated if its version
+// matches the one of the record, and the version is incremented. Otherwise,
+// ErrStaleRecord is returned.
 // Returns the number of updated rows and an error, if any.
 func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64, error) {
+	return s.UpdateContext(context.Background(), schema, record, cols...)
+}
+
+// UpdateContext updates the given fields of a record in the table using the
+// given context. See Update for more details.
+func (s *Store) UpdateContext(ctx context.Context, schema Schema, record Record, cols ...SchemaField) (updated int64, err error) {
+	ctx, span := s.startOperation(ctx, UpdateOperation, schema.Table())
+	defer func() { span.Finish(err) }()
+
 	if !record.IsWritable() {
 		return 0, ErrNotWritable
 	}
@@ -200,7 +528,7 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 	}
 
 	columnNames := ColumnNames(cols)
-	values, err := RecordValues(record, columnNames...)
+	values, err := s.recordValues(record, columnNames...)
 	if err != nil {
 		return 0, err
 	}
@@ -210,13 +538,17 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 		clauses[col] = values[i]
 	}
 
+	if version := schema.versionColumn(); version != nil {
+		return s.updateVersioned(ctx, schema, record, version, clauses)
+	}
+
 	result, err := s.builder.
 		Update(schema.Table()).
 		SetMap(clauses).
 		Where(squirrel.Eq{
 			schema.ID().String(): record.GetID(),
 		}).
-		Exec()
+		ExecContext(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -226,6 +558,21 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 		return 0, err
 	}
 
+	if cnt == 0 && s.dialect.countsChangedRows() {
+		// the row may exist with the same values it was updated with
+		err = s.builder.
+			Select("1").
+			From(schema.Table()).
+			Where(squirrel.Eq{schema.ID().String(): record.GetID()}).
+			QueryRowContext(ctx).
+			Scan(new(int))
+		if err == nil {
+			cnt = 1
+		} else if err != sql.ErrNoRows {
+			return 0, err
+		}
+	}
+
 	if cnt == 0 {
 		return 0, ErrNoRowUpdate
 	}
@@ -233,13 +580,74 @@ func (s *Store) Update(schema Schema, record Record, cols ...SchemaField) (int64
 	return cnt, nil
 }
 
+// updateVersioned updates the record only if its version matches the one in
+// the database, incrementing it in both the database and the record.
+func (s *Store) updateVersioned(ctx context.Context, schema Schema, record Record, version SchemaField, clauses map[string]interface{}) (int64, error) {
+	current, err := record.Value(version.String())
+	if err != nil {
+		return 0, err
+	}
+
+	ptr, err := record.ColumnAddress(version.String())
+	if err != nil {
+		return 0, err
+	}
+
+	delete(clauses, version.String())
+	quoted := s.dialect.QuoteIdentifier(version.String())
+	builder := s.builder.
+		Update(schema.Table()).
+		SetMap(clauses).
+		Set(version.String(), squirrel.Expr(quoted+" + 1")).
+		Where(squirrel.Eq{
+			schema.ID().String(): record.GetID(),
+			version.String():     current,
+		})
+
+	if !s.dialect.supportsReturning() {
+		result, err := builder.ExecContext(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		cnt, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+
+		if cnt == 0 {
+			return 0, ErrStaleRecord
+		}
+
+		return 1, incrementVersion(ptr)
+	}
+
+	err = builder.
+		Suffix("RETURNING " + quoted).
+		QueryRowContext(ctx).
+		Scan(ptr)
+	if err == sql.ErrNoRows {
+		return 0, ErrStaleRecord
+	} else if err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
+
 // Save inserts or updates the given record in the table.
 func (s *Store) Save(schema Schema, record Record) (updated bool, err error) {
+	return s.SaveContext(context.Background(), schema, record)
+}
+
+// SaveContext inserts or updates the given record in the table using the given
+// context.
+func (s *Store) SaveContext(ctx context.Context, schema Schema, record Record) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(schema, record)
+		return false, s.InsertContext(ctx, schema, record)
 	}
 
-	rowsUpdated, err := s.Update(schema, record)
+	rowsUpdated, err := s.UpdateContext(ctx, schema, record)
 	if err != nil {
 		return false, err
 	}
@@ -247,9 +655,360 @@ func (s *Store) Save(schema Schema, record Record) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record in the table or, if there is a conflict
+// with the given conflict columns, updates the given columns of the existing
+// row instead. If no update columns are given, the conflicting row is left
+// as it is. If the schema has a version column, the version of the
+// conflicting row is incremented when it is updated. Returns error if a
+// non-new record is given.
+// After the upsert, the record is filled with the values of the row in the
+// database, ID included, and is marked as persisted and writable. If the
+// conflicting row was left untouched, the record will not be modified nor
+// marked as persisted.
+func (s *Store) Upsert(schema Schema, record Record, conflictCols []SchemaField, updateCols ...SchemaField) error {
+	return s.UpsertContext(context.Background(), schema, record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record in the table using the
+// given context. See Upsert for more details.
+func (s *Store) UpsertContext(ctx context.Context, schema Schema, record Record, conflictCols []SchemaField, updateCols ...SchemaField) error {
+	ctx = withOperation(ctx, UpsertOperation, schema.Table())
+
+	if record.IsPersisted() {
+		return ErrNonNewDocument
+	}
+
+	if len(updateCols) > 0 && len(conflictCols) == 0 {
+		return ErrNoConflictTarget
+	}
+
+	cols := ColumnNames(schema.Columns())
+	if schema.isPrimaryKeyAutoIncrementable() {
+		cols = cols[1:]
+	}
+
+	values, err := s.recordValues(record, cols...)
+	if err != nil {
+		return err
+	}
+
+	builder := s.builder.
+		Insert(schema.Table()).
+		Columns(cols...).
+		Values(values...)
+	onConflict := s.dialect.upsertClause(schema, conflictCols, updateCols)
+	if !s.dialect.supportsReturning() {
+		return s.upsertLastInsertID(ctx, schema, record, builder.Suffix(onConflict), conflictCols, len(updateCols) > 0)
+	}
+
+	allCols := ColumnNames(schema.Columns())
+	var pointers = make([]interface{}, len(allCols))
+	for i, col := range allCols {
+		pointers[i], err = record.ColumnAddress(col)
+		if err != nil {
+			return err
+		}
+		pointers[i] = s.dialect.column(pointers[i])
+	}
+
+	err = builder.
+		Suffix(fmt.Sprintf(
+			"%s RETURNING %s",
+			onConflict,
+			strings.Join(quoteColumns(s.dialect, allCols), ", "),
+		)).
+		QueryRowContext(ctx).
+		Scan(pointers...)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	record.setWritable(true)
+	record.setPersisted()
+	return nil
+}
+
+// recordValues returns the values of a record at the given columns, as
+// RecordValues does, converted to the way the dialect of the store stores
+// them.
+func (s *Store) recordValues(record Valuer, columns ...string) ([]interface{}, error) {
+	values, err := RecordValues(record, columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range values {
+		values[i] = s.dialect.column(v)
+	}
+	return values, nil
+}
+
+func quoteColumns(dialect Dialect, cols []string) []string {
+	var quoted = make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = dialect.QuoteIdentifier(col)
+	}
+	return quoted
+}
+
+// execLastInsertID executes the given insert and sets the id of the inserted
+// row in the given pointer, for dialects without RETURNING.
+func (s *Store) execLastInsertID(ctx context.Context, builder squirrel.InsertBuilder, pk interface{}) error {
+	result, err := builder.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return setInt64(pk, id)
+}
+
+// errInsertedIDs returns the error of an insert of many records that returned
+// a different number of ids than records.
+func errInsertedIDs(ids, records int) error {
+	return fmt.Errorf("kallax: insert returned %d ids for %d records", ids, records)
+}
+
+// insertManyLastInsertID executes the given insert of many records and sets
+// their ids, for dialects without RETURNING or whose RETURNING rows are not
+// ordered. The ids of the rows inserted by a single statement are
+// consecutive, starting at the first insert id of the dialect.
+func (s *Store) insertManyLastInsertID(ctx context.Context, schema Schema, builder squirrel.InsertBuilder, records []Record) error {
+	result, err := builder.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	id = s.dialect.firstInsertID(id, len(records))
+	for i, r := range records {
+		pk, err := r.ColumnAddress(schema.ID().String())
+		if err != nil {
+			return err
+		}
+
+		if err := setInt64(pk, id+int64(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertLastInsertID executes the given upsert for dialects without
+// RETURNING. Only the id of the record is set after it, and the record is
+// only marked as persisted if the row was inserted or updated, even with
+// the same values it had, in which case its id is read by the given conflict
+// columns.
+func (s *Store) upsertLastInsertID(ctx context.Context, schema Schema, record Record, builder squirrel.InsertBuilder, conflictCols []SchemaField, update bool) error {
+	result, err := builder.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	cnt, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if cnt == 0 {
+		if !update || !s.dialect.countsChangedRows() {
+			return nil
+		}
+		return s.upsertedID(ctx, schema, record, conflictCols)
+	}
+
+	if schema.isPrimaryKeyAutoIncrementable() {
+		pk, err := record.ColumnAddress(schema.ID().String())
+		if err != nil {
+			return err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if err := setInt64(pk, id); err != nil {
+			return err
+		}
+	}
+
+	record.setWritable(true)
+	record.setPersisted()
+	return nil
+}
+
+// upsertedID reads the id of the row of the given record that was updated
+// by an upsert without changing its values, which is found by the given
+// conflict columns, and marks the record as persisted.
+func (s *Store) upsertedID(ctx context.Context, schema Schema, record Record, conflictCols []SchemaField) error {
+	cols := ColumnNames(conflictCols)
+	values, err := s.recordValues(record, cols...)
+	if err != nil {
+		return err
+	}
+
+	var where = make(squirrel.Eq, len(cols))
+	for i, col := range cols {
+		where[col] = values[i]
+	}
+
+	pk, err := record.ColumnAddress(schema.ID().String())
+	if err != nil {
+		return err
+	}
+
+	err = s.builder.
+		Select(schema.ID().String()).
+		From(schema.Table()).
+		Where(where).
+		QueryRowContext(ctx).
+		Scan(s.dialect.column(pk))
+	if err != nil {
+		return err
+	}
+
+	record.setWritable(true)
+	record.setPersisted()
+	return nil
+}
+
+// setInt64 sets the given integer in the value pointed by ptr, which must be
+// a scanner or a pointer to an integer.
+func setInt64(ptr interface{}, n int64) error {
+	if s, ok := ptr.(sql.Scanner); ok {
+		return s.Scan(n)
+	}
+
+	v := reflect.ValueOf(ptr)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(n)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v.SetUint(uint64(n))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("kallax: can't set integer in value of type %T", ptr)
+}
+
+// incrementVersion increments the version pointed by ptr, which must be a
+// pointer to an integer.
+func incrementVersion(ptr interface{}) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(v.Int() + 1)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v.SetUint(v.Uint() + 1)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("kallax: can't increment version of type %T", ptr)
+}
+
 // Delete removes the record from the table. A non-new record with non-empty
 // ID is required.
+// If the schema has soft delete, the record is not removed but marked as
+// deleted, setting the time of deletion both in the database and the record.
 func (s *Store) Delete(schema Schema, record Record) error {
+	return s.DeleteContext(context.Background(), schema, record)
+}
+
+// DeleteContext removes the record from the table using the given context.
+// See Delete for more details.
+func (s *Store) DeleteContext(ctx context.Context, schema Schema, record Record) error {
+	ctx = withOperation(ctx, DeleteOperation, schema.Table())
+
+	if record.GetID().IsEmpty() {
+		return ErrEmptyID
+	}
+
+	if col := schema.softDeleteColumn(); col != nil {
+		return s.softDelete(ctx, schema, record, col)
+	}
+
+	return s.HardDeleteContext(ctx, schema, record)
+}
+
+// softDelete marks the record as deleted, setting the current time in the
+// given column, unless it was already deleted.
+func (s *Store) softDelete(ctx context.Context, schema Schema, record Record, col SchemaField) error {
+	ptr, err := record.ColumnAddress(col.String())
+	if err != nil {
+		return err
+	}
+
+	builder := s.builder.
+		Update(schema.Table()).
+		Set(col.String(), squirrel.Expr(s.dialect.currentTimestamp())).
+		Where(squirrel.Eq{
+			schema.ID().String(): record.GetID(),
+			col.String():         nil,
+		})
+
+	if !s.dialect.supportsReturning() {
+		result, err := builder.ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		cnt, err := result.RowsAffected()
+		if err != nil || cnt == 0 {
+			return err
+		}
+
+		// the time of deletion set by the database is read back, as it
+		// can not be returned by the update
+		return s.builder.
+			Select(col.String()).
+			From(schema.Table()).
+			Where(squirrel.Eq{schema.ID().String(): record.GetID()}).
+			QueryRowContext(ctx).
+			Scan(ptr)
+	}
+
+	err = builder.
+		Suffix("RETURNING " + s.dialect.QuoteIdentifier(col.String())).
+		QueryRowContext(ctx).
+		Scan(ptr)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	return err
+}
+
+// HardDelete removes the record from the table, even if the schema has soft
+// delete. A non-new record with non-empty ID is required.
+func (s *Store) HardDelete(schema Schema, record Record) error {
+	return s.HardDeleteContext(context.Background(), schema, record)
+}
+
+// HardDeleteContext removes the record from the table using the given
+// context, even if the schema has soft delete. A non-new record with
+// non-empty ID is required.
+func (s *Store) HardDeleteContext(ctx context.Context, schema Schema, record Record) error {
+	ctx = withOperation(ctx, DeleteOperation, schema.Table())
+
 	if record.GetID().IsEmpty() {
 		return ErrEmptyID
 	}
@@ -259,27 +1018,139 @@ func (s *Store) Delete(schema Schema, record Record) error {
 		Where(squirrel.Eq{
 			schema.ID().String(): record.GetID(),
 		}).
-		Exec()
+		ExecContext(ctx)
 	return err
 }
 
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query, using a single statement. If the schema has a
+// version column and no value is given for it, it is incremented in all the
+// updated rows. Returns the number of updated rows.
+// No events are triggered and no relationships are updated. Queries with
+// relationships, limit or offset are not supported.
+func (s *Store) UpdateAll(q Query, values map[SchemaField]interface{}) (int64, error) {
+	return s.UpdateAllContext(context.Background(), q, values)
+}
+
+// UpdateAllContext updates all the rows matching the given query using the
+// given context. See UpdateAll for more details.
+func (s *Store) UpdateAllContext(ctx context.Context, q Query, values map[SchemaField]interface{}) (int64, error) {
+	ctx = withOperation(ctx, UpdateOperation, q.Schema().Table())
+
+	if !isSetQuery(q) {
+		return 0, ErrSetQueryNotSupported
+	}
+
+	return s.updateAll(ctx, q, values)
+}
+
+// updateAll updates all the rows matching the given set query.
+func (s *Store) updateAll(ctx context.Context, q Query, values map[SchemaField]interface{}) (int64, error) {
+	var clauses = make(map[string]interface{}, len(values))
+	for col, v := range values {
+		clauses[col.String()] = v
+	}
+
+	if version := q.Schema().versionColumn(); version != nil {
+		if _, ok := clauses[version.String()]; !ok {
+			clauses[version.String()] = squirrel.Expr(s.dialect.QuoteIdentifier(version.String()) + " + 1")
+		}
+	}
+
+	builder := s.builder.
+		Update(s.dialect.aliasedTable(q.Schema().Table(), q.Schema().Alias())).
+		SetMap(clauses)
+	for _, cond := range q.getConditions(s.dialect) {
+		builder = builder.Where(cond)
+	}
+
+	result, err := builder.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// DeleteAll removes all the rows matching the given query using a single
+// statement. If the schema has soft delete, the rows are marked as deleted
+// instead. Returns the number of deleted rows.
+// No events are triggered and no relationships are removed. Queries with
+// relationships, limit or offset are not supported.
+func (s *Store) DeleteAll(q Query) (int64, error) {
+	return s.DeleteAllContext(context.Background(), q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context. See DeleteAll for more details.
+func (s *Store) DeleteAllContext(ctx context.Context, q Query) (int64, error) {
+	ctx = withOperation(ctx, DeleteOperation, q.Schema().Table())
+
+	if !isSetQuery(q) {
+		return 0, ErrSetQueryNotSupported
+	}
+
+	if col := q.Schema().softDeleteColumn(); col != nil {
+		return s.updateAll(ctx, q, map[SchemaField]interface{}{
+			col: squirrel.Expr(s.dialect.currentTimestamp()),
+		})
+	}
+
+	builder := s.builder.Delete(s.dialect.aliasedTable(q.Schema().Table(), q.Schema().Alias()))
+	for _, cond := range q.getConditions(s.dialect) {
+		builder = builder.Where(cond)
+	}
+
+	result, err := builder.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// isSetQuery reports whether the query can be used to update or delete a set
+// of rows, that is, it has no relationships, limit nor offset.
+func isSetQuery(q Query) bool {
+	return len(q.getRelationships()) == 0 && q.GetLimit() == 0 && q.GetOffset() == 0
+}
+
 // RawQuery performs a raw SQL query with the given parameters and returns a
 // result set with the results.
 // WARNING: A result set created from a raw query can only be scanned using the
 // RawScan method of ResultSet, instead of Scan.
 func (s *Store) RawQuery(sql string, params ...interface{}) (ResultSet, error) {
-	rows, err := s.proxy.Query(sql, params...)
+	return s.RawQueryContext(context.Background(), sql, params...)
+}
+
+// RawQueryContext performs a raw SQL query with the given parameters using
+// the given context. See RawQuery for more details.
+func (s *Store) RawQueryContext(ctx context.Context, sql string, params ...interface{}) (ResultSet, error) {
+	ctx = withOperation(ctx, RawOperation, "")
+	ctx, hooks := withRowsHooks(ctx)
+
+	rows, err := s.proxy.QueryContext(ctx, sql, params...)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewResultSet(rows, true, nil), nil
+	rs := NewResultSet(rows, true, nil)
+	rs.hooks = hooks
+	return rs, nil
 }
 
 // RawExec executes a raw SQL query with the given parameters and returns
 // the number of affected rows.
 func (s *Store) RawExec(sql string, params ...interface{}) (int64, error) {
-	result, err := s.proxy.Exec(sql, params...)
+	return s.RawExecContext(context.Background(), sql, params...)
+}
+
+// RawExecContext executes a raw SQL query with the given parameters using the
+// given context and returns the number of affected rows.
+func (s *Store) RawExecContext(ctx context.Context, sql string, params ...interface{}) (int64, error) {
+	ctx = withOperation(ctx, RawOperation, "")
+
+	result, err := s.proxy.ExecContext(ctx, sql, params...)
 	if err != nil {
 		return 0, err
 	}
@@ -289,12 +1160,36 @@ func (s *Store) RawExec(sql string, params ...interface{}) (int64, error) {
 
 // Find performs a query and returns a result set with the results.
 func (s *Store) Find(q Query) (ResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext performs a query using the given context and returns a result
+// set with the results. If the query has 1:N relationships or uses a cursor,
+// the context will also be used to retrieve every batch of the result set.
+// The cursor and the transaction opened for it, if the store is not in one,
+// are closed once all the rows are fetched or the result set is closed.
+func (s *Store) FindContext(ctx context.Context, q Query) (ResultSet, error) {
+	if err := s.checkLock(q); err != nil {
+		return nil, err
+	}
+
+	if q.usesCursor() {
+		return s.findCursor(ctx, q)
+	}
+
+	// the rows of reversed queries are retrieved in a batch to be reversed
 	rels := q.getRelationships()
-	if containsRelationshipOfType(rels, OneToMany) {
-		return NewBatchingResultSet(newBatchQueryRunner(q.Schema(), s.proxy, q)), nil
+	if containsRelationshipOfType(rels, OneToMany) || q.isReversed() {
+		ctx = withOperation(ctx, FindOperation, q.Schema().Table())
+		runner := newBatchQueryRunner(ctx, q.Schema(), s.proxy, q, s.dialect)
+		runner.tracer = s.tracer
+		runner.metrics = s.metrics
+		return NewBatchingResultSet(runner), nil
 	}
 
-	columns, builder := q.compile()
+	ctx, span := s.startOperation(ctx, FindOperation, q.Schema().Table())
+
+	columns, builder := q.compile(s.dialect)
 	if offset := q.GetOffset(); offset > 0 {
 		builder = builder.Offset(offset)
 	}
@@ -303,17 +1198,30 @@ func (s *Store) Find(q Query) (ResultSet, error) {
 		builder = builder.Limit(limit)
 	}
 
-	rows, err := builder.RunWith(s.proxy).Query()
+	ctx, hooks := withRowsHooks(ctx)
+	rows, err := querySelect(ctx, s.proxy, builder)
 	if err != nil {
+		span.Finish(err)
 		return nil, err
 	}
 
-	return NewResultSet(
+	// the span is finished once all the rows are read
+	hooks.fns = append(hooks.fns, func(rows int64, err error) {
+		span.SetTag("db.rows", rows)
+		span.Finish(err)
+	})
+
+	rs := newResultSet(
 		rows,
 		q.isReadOnly(),
 		q.getRelationships(),
 		columns...,
-	), nil
+	)
+	rs.hooks = hooks
+	rs.dialect = s.dialect
+	rs.keyset = q.keyset()
+	reportRowsScanned(s.metrics, q.Schema().Table(), rs)
+	return rs, nil
 }
 
 // MustFind performs a query and returns a result set with the results.
@@ -329,21 +1237,35 @@ func (s *Store) MustFind(q Query) ResultSet {
 // Reload refreshes the record with the data in the database and makes the
 // record writable.
 func (s *Store) Reload(schema Schema, record Record) error {
+	return s.ReloadContext(context.Background(), schema, record)
+}
+
+// ReloadContext refreshes the record with the data in the database using the
+// given context and makes the record writable.
+func (s *Store) ReloadContext(ctx context.Context, schema Schema, record Record) error {
+	ctx = withOperation(ctx, FindOperation, schema.Table())
+
 	if record.GetID().IsEmpty() {
 		return ErrEmptyID
 	}
 
 	q := NewBaseQuery(schema)
+	q.WithDeleted()
 	q.Where(Eq(schema.ID(), record.GetID()))
 	q.Limit(1)
-	columns, builder := q.compile()
+	columns, builder := q.compile(s.dialect)
 
-	rows, err := builder.RunWith(s.proxy).Query()
+	ctx, hooks := withRowsHooks(ctx)
+	rows, err := querySelect(ctx, s.proxy, builder)
 	if err != nil {
 		return err
 	}
 
-	rs := NewResultSet(rows, false, nil, columns...)
+	rs := newResultSet(rows, false, nil, columns...)
+	rs.hooks = hooks
+	rs.dialect = s.dialect
+	reportRowsScanned(s.metrics, schema.Table(), rs)
+	defer rs.Close()
 	if !rs.Next() {
 		return ErrNotFound
 	}
@@ -353,11 +1275,22 @@ func (s *Store) Reload(schema Schema, record Record) error {
 
 // Count returns the number of rows selected by the given query.
 func (s *Store) Count(q Query) (count int64, err error) {
-	_, queryBuilder := q.compile()
+	return s.CountContext(context.Background(), q)
+}
+
+// CountContext returns the number of rows selected by the given query using
+// the given context.
+func (s *Store) CountContext(ctx context.Context, q Query) (count int64, err error) {
+	ctx, span := s.startOperation(ctx, CountOperation, q.Schema().Table())
+	defer func() { span.Finish(err) }()
+
+	_, queryBuilder := q.compile(s.dialect)
+	// rows can not be locked along with aggregates
+	queryBuilder = builder.Set(queryBuilder, "Suffixes", nil).(squirrel.SelectBuilder)
 	builder := builder.Set(queryBuilder, "Columns", nil).(squirrel.SelectBuilder)
 	err = builder.Column(fmt.Sprintf("COUNT(%s)", q.Schema().ID())).
 		RunWith(s.proxy).
-		QueryRow().
+		QueryRowContext(ctx).
 		Scan(&count)
 	return
 }
@@ -377,28 +1310,185 @@ func (s *Store) MustCount(q Query) int64 {
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-// If a transaction is already opened in this store, instead of opening a new
-// one, the other will be reused.
+// If a transaction is already opened in this store, a savepoint is created in
+// it instead of opening a new one. If the callback returns an error, only the
+// changes made since the savepoint are rolled back, otherwise the savepoint
+// is released.
 func (s *Store) Transaction(callback func(*Store) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned. If the context
+// is cancelled, the transaction will be rolled back by the database driver.
+// See Transaction for more details.
+func (s *Store) TransactionContext(ctx context.Context, callback func(*Store) error) error {
+	return s.TransactionWithContext(ctx, nil, callback)
+}
+
+// LevelSerializableDeferrable is a PostgreSQL specific isolation level that
+// can be used in the options given to TransactionWith. The transaction is
+// started as SERIALIZABLE and then set as DEFERRABLE, which only has effect
+// if the transaction is also read-only.
+const LevelSerializableDeferrable sql.IsolationLevel = 1000
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options, such as the isolation level or whether it is read-only,
+// and rollbacks if an error is returned. If no options are given, the
+// default ones are used.
+// Options can not be given if the store is already in a transaction, in
+// which case ErrTxOptionsInTransaction is returned.
+// See Transaction for more details.
+func (s *Store) TransactionWith(opts *sql.TxOptions, callback func(*Store) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options. See TransactionWith for more details.
+func (s *Store) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*Store) error) (err error) {
+	ctx, span := s.startOperation(ctx, TransactionOperation, "")
+	defer func() { span.Finish(err) }()
+
 	if s.db == nil {
-		return callback(s)
+		if opts != nil {
+			return ErrTxOptionsInTransaction
+		}
+
+		return s.savepoint(ctx, callback)
 	}
 
-	tx, err := s.db.Begin()
+	if s.retry == nil || s.dialect.restartsTransactions() {
+		return s.transaction(ctx, opts, callback)
+	}
+
+	return s.retry.run(ctx, s.logger, func() error {
+		return s.transaction(ctx, opts, callback)
+	})
+}
+
+// transaction executes the given callback in a new transaction started with
+// the given context and options.
+func (s *Store) transaction(ctx context.Context, opts *sql.TxOptions, callback func(*Store) error) error {
+	opts, setup := txOptions(opts)
+	tx, err := s.db.BeginTx(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("kallax: can't open transaction: %s", err)
+		return &txError{"kallax: can't open transaction", err}
+	}
+
+	for _, stmt := range setup {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			if err := tx.Rollback(); err != nil {
+				return fmt.Errorf("kallax: unable to rollback transaction: %s", err)
+			}
+			reportTransaction(s.metrics, "rollback")
+
+			return &txError{"kallax: can't set transaction options", err}
+		}
 	}
 
-	if err := callback(newStoreWithTransaction(tx)); err != nil {
+	store := s.newStoreWithTransaction(tx)
+	if s.dialect.restartsTransactions() {
+		err = s.restartable(ctx, tx, store, callback)
+	} else {
+		err = callback(store)
+	}
+
+	if err != nil {
 		if err := tx.Rollback(); err != nil {
 			return fmt.Errorf("kallax: unable to rollback transaction: %s", err)
 		}
+		reportTransaction(s.metrics, "rollback")
 
 		return err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("kallax: unable to commit transaction: %s", err)
+		return &txError{"kallax: unable to commit transaction", err}
+	}
+	reportTransaction(s.metrics, "commit")
+
+	return nil
+}
+
+// restartSavepoint is the savepoint CockroachDB transactions are restarted
+// from when they need to be retried.
+const restartSavepoint = "cockroach_restart"
+
+// defaultRestartPolicy is the policy used to restart CockroachDB transactions
+// when the store has no retry policy.
+var defaultRestartPolicy = RetryPolicy{MaxAttempts: 10}
+
+// restartable executes the given callback in the given transaction using the
+// client-side retry protocol of CockroachDB: the callback is run after
+// creating the cockroach_restart savepoint, which is released once it
+// succeeds. If the callback or the release fail with a retryable error, the
+// transaction is rolled back to the savepoint and the callback is run again,
+// according to the retry policy of the store.
+func (s *Store) restartable(ctx context.Context, tx *sql.Tx, store *Store, callback func(*Store) error) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+restartSavepoint); err != nil {
+		return &txError{"kallax: can't create savepoint", err}
+	}
+
+	policy := s.retry
+	if policy == nil {
+		policy = &defaultRestartPolicy
+	}
+
+	var restart bool
+	return policy.run(ctx, s.logger, func() error {
+		if restart {
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+restartSavepoint); err != nil {
+				return fmt.Errorf("kallax: unable to rollback to savepoint: %s", err)
+			}
+		}
+		restart = true
+
+		if err := callback(store); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+restartSavepoint); err != nil {
+			return &txError{"kallax: unable to release savepoint", err}
+		}
+		return nil
+	})
+}
+
+// txOptions returns the options to begin a transaction with and the
+// statements to execute right after it for the settings database/sql does
+// not support.
+func txOptions(opts *sql.TxOptions) (*sql.TxOptions, []string) {
+	if opts == nil || opts.Isolation != LevelSerializableDeferrable {
+		return opts, nil
+	}
+
+	return &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+		ReadOnly:  opts.ReadOnly,
+	}, []string{"SET TRANSACTION DEFERRABLE"}
+}
+
+// savepoint executes the given callback in a savepoint of the transaction
+// the store is in and rolls back to it if an error is returned.
+func (s *Store) savepoint(ctx context.Context, callback func(*Store) error) error {
+	store := s.copy()
+	store.savepoints++
+	name := fmt.Sprintf("kallax_savepoint_%d", store.savepoints)
+
+	if _, err := s.proxy.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("kallax: can't create savepoint: %s", err)
+	}
+
+	if err := callback(store); err != nil {
+		if _, err := s.proxy.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); err != nil {
+			return fmt.Errorf("kallax: unable to rollback to savepoint: %s", err)
+		}
+
+		return err
+	}
+
+	if _, err := s.proxy.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("kallax: unable to release savepoint: %s", err)
 	}
 
 	return nil
@@ -1,11 +1,14 @@
 package kallax
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -23,7 +26,7 @@ func (s *StoreSuite) SetupTest() {
 	s.db, err = openTestDB()
 	s.NoError(err)
 
-	s.store = NewStore(s.db)
+	s.store = newTestStore(s.db)
 	setupTables(s.T(), s.db)
 
 	s.errDB, err = sql.Open("postgres", "postgres://0.0.0.0:5432/notexists")
@@ -61,6 +64,78 @@ func (s *StoreSuite) TestInsert_IDEmpty() {
 	s.False(m.GetID().IsEmpty())
 }
 
+func (s *StoreSuite) TestInsertMany() {
+	var models = []Record{
+		newModel("a", "a@a.a", 1),
+		newModel("b", "b@b.b", 2),
+		newModel("c", "c@c.c", 3),
+	}
+	s.NoError(s.store.InsertMany(ModelSchema, models...))
+	s.assertCount(3)
+
+	for _, m := range models {
+		s.True(m.IsPersisted(), "model should be persisted now")
+		s.True(m.IsWritable(), "model should be writable now")
+		s.assertModel(m.(*model))
+	}
+}
+
+func (s *StoreSuite) TestInsertMany_Chunks() {
+	var models = make([]Record, PostgreSQL.maxParams()/3+10)
+	for i := range models {
+		models[i] = newModel(fmt.Sprint(i), "", i)
+	}
+	s.NoError(s.store.InsertMany(ModelSchema, models...))
+	s.assertCount(int64(len(models)))
+
+	for _, m := range models {
+		s.False(m.GetID().IsEmpty())
+	}
+}
+
+func (s *StoreSuite) TestInsertMany_NotNew() {
+	var m model
+	m.setPersisted()
+	s.Equal(ErrNonNewDocument, s.store.InsertMany(ModelSchema, newModel("a", "", 1), &m))
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestCopyFrom() {
+	var models = []Record{
+		newModel("a", "a@a.a", 1),
+		newModel("b\tb", "b@b.b", 2),
+		newModel("c\\c", "c@c.c", 3),
+	}
+	n, err := s.store.CopyFrom(ModelSchema, NewRecordIterator(models...))
+	s.NoError(err)
+	s.Equal(int64(3), n)
+	s.assertCount(3)
+
+	q := NewBaseQuery(ModelSchema)
+	q.Order(Asc(f("age")))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+	for _, m := range models {
+		s.True(rs.Next())
+		record, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		s.Equal(m.(*model).Name, record.(*model).Name)
+	}
+	s.NoError(rs.Close())
+}
+
+func (s *StoreSuite) TestCopyFrom_Fail() {
+	var i int
+	_, err := s.store.CopyFrom(ModelSchema, RecordIteratorFunc(func() (Record, error) {
+		if i++; i > 2 {
+			return nil, errors.New("foo")
+		}
+		return newModel("a", "", i), nil
+	}))
+	s.EqualError(err, "foo")
+	s.assertCount(0)
+}
+
 func (s *StoreSuite) TestUpdate() {
 	var m = newModel("a", "a@a.a", 1)
 	s.NoError(s.store.Insert(ModelSchema, m))
@@ -130,6 +205,45 @@ func (s *StoreSuite) TestSave() {
 	s.Equal(ErrNotWritable, err)
 }
 
+func (s *StoreSuite) TestUpsert() {
+	_, err := s.db.Exec("CREATE UNIQUE INDEX model_email ON model (email)")
+	s.NoError(err)
+
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Upsert(ModelSchema, m, []SchemaField{f("email")}, f("name"), f("age")))
+	s.True(m.IsPersisted(), "model should be persisted now")
+	s.True(m.IsWritable(), "model should be writable now")
+	s.assertModel(m)
+
+	other := newModel("b", "a@a.a", 2)
+	s.NoError(s.store.Upsert(ModelSchema, other, []SchemaField{f("email")}, f("name"), f("age")))
+	s.True(other.IsPersisted(), "model should be persisted now")
+	s.Equal(m.ID, other.ID, "existing row should have been updated")
+	s.assertModel(other)
+	s.assertCount(1)
+
+	s.Equal(ErrNonNewDocument, s.store.Upsert(ModelSchema, other, []SchemaField{f("email")}))
+}
+
+func (s *StoreSuite) TestUpsert_DoNothing() {
+	_, err := s.db.Exec("CREATE UNIQUE INDEX model_email ON model (email)")
+	s.NoError(err)
+
+	m := newModel("a", "a@a.a", 1)
+	s.NoError(s.store.Insert(ModelSchema, m))
+
+	other := newModel("b", "a@a.a", 2)
+	s.NoError(s.store.Upsert(ModelSchema, other, []SchemaField{f("email")}))
+	s.False(other.IsPersisted(), "model should not be persisted")
+	s.assertModel(m)
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestUpsert_NoConflictTarget() {
+	m := newModel("a", "a@a.a", 1)
+	s.Equal(ErrNoConflictTarget, s.store.Upsert(ModelSchema, m, nil, f("name")))
+}
+
 func (s *StoreSuite) TestDelete() {
 	m := newModel("a", "a@a.a", 1)
 	s.NoError(s.store.Insert(ModelSchema, m))
@@ -142,6 +256,51 @@ func (s *StoreSuite) TestDelete() {
 	s.Equal(ErrEmptyID, s.store.Delete(nil, &mod))
 }
 
+func (s *StoreSuite) TestUpdateAll() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Jane", "", 2)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Anna", "", 2)))
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 1))
+	cnt, err := s.store.UpdateAll(q, map[SchemaField]interface{}{
+		f("email"): "foo@foo.foo",
+		f("age"):   3,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), cnt)
+
+	q = NewBaseQuery(ModelSchema)
+	q.Where(Eq(f("email"), "foo@foo.foo"))
+	q.Where(Eq(f("age"), 3))
+	s.assertFound(s.store.MustFind(q), "Jane", "Anna")
+}
+
+func (s *StoreSuite) TestDeleteAll() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Jane", "", 2)))
+	s.NoError(s.store.Insert(ModelSchema, newModel("Anna", "", 2)))
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Gt(f("age"), 1))
+	cnt, err := s.store.DeleteAll(q)
+	s.NoError(err)
+	s.Equal(int64(2), cnt)
+
+	s.assertFound(s.store.MustFind(NewBaseQuery(ModelSchema)), "Joe")
+}
+
+func (s *StoreSuite) TestUpdateAllDeleteAll_NotSupported() {
+	q := NewBaseQuery(ModelSchema)
+	q.Limit(1)
+
+	_, err := s.store.UpdateAll(q, map[SchemaField]interface{}{f("age"): 1})
+	s.Equal(ErrSetQueryNotSupported, err)
+
+	_, err = s.store.DeleteAll(q)
+	s.Equal(ErrSetQueryNotSupported, err)
+}
+
 func (s *StoreSuite) TestRawQuery() {
 	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
 	s.NoError(s.store.Insert(ModelSchema, newModel("Jane", "", 2)))
@@ -310,6 +469,235 @@ func (s *StoreSuite) TestTransaction() {
 	s.assertCount(2)
 }
 
+func (s *StoreSuite) TestTransaction_NestedRollback() {
+	err := s.store.Transaction(func(store *Store) error {
+		s.NoError(store.Insert(ModelSchema, newModel("Joe", "", 1)))
+
+		err := store.Transaction(func(store *Store) error {
+			s.NoError(store.Insert(ModelSchema, newModel("Anna", "", 1)))
+
+			s.NoError(store.Transaction(func(store *Store) error {
+				return store.Insert(ModelSchema, newModel("Jane", "", 1))
+			}))
+
+			return fmt.Errorf("kallax: inner transaction failed")
+		})
+		s.Error(err)
+
+		return store.Insert(ModelSchema, newModel("John", "", 1))
+	})
+	s.NoError(err)
+	s.assertCount(2)
+}
+
+func (s *StoreSuite) TestTransactionWith() {
+	err := s.store.TransactionWith(&sql.TxOptions{ReadOnly: true}, func(store *Store) error {
+		return store.Insert(ModelSchema, newModel("Joe", "", 1))
+	})
+	s.Error(err)
+
+	opts := &sql.TxOptions{Isolation: LevelSerializableDeferrable, ReadOnly: true}
+	err = s.store.TransactionWith(opts, func(store *Store) error {
+		var mode string
+		if err := store.proxy.QueryRow("SHOW transaction_deferrable").Scan(&mode); err != nil {
+			return err
+		}
+		s.Equal("on", mode)
+
+		return store.TransactionWith(opts, func(store *Store) error {
+			return nil
+		})
+	})
+	s.Equal(ErrTxOptionsInTransaction, err)
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestTransaction_Retry() {
+	var retries []int
+	store := s.store.WithRetry(RetryPolicy{
+		MaxAttempts: 3,
+		OnRetry: func(retry int, err error) {
+			retries = append(retries, retry)
+		},
+	})
+
+	var calls int
+	err := store.Transaction(func(store *Store) error {
+		calls++
+		if err := store.Insert(ModelSchema, newModel("Joe", "", 1)); err != nil {
+			return err
+		}
+
+		if calls < 3 {
+			return &pq.Error{Code: "40001"}
+		}
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(3, calls)
+	s.Equal([]int{1, 2}, retries)
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestTransaction_CockroachRestart() {
+	var retries []int
+	store := NewStoreWithDialect(s.db, CockroachDB).WithRetry(RetryPolicy{
+		MaxAttempts: 3,
+		OnRetry: func(retry int, err error) {
+			retries = append(retries, retry)
+		},
+	})
+
+	var calls int
+	err := store.Transaction(func(store *Store) error {
+		calls++
+		if err := store.Insert(ModelSchema, newModel("Joe", "", 1)); err != nil {
+			return err
+		}
+
+		if calls < 3 {
+			return &pq.Error{Code: "40001"}
+		}
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(3, calls)
+	s.Equal([]int{1, 2}, retries)
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestTransaction_CockroachRestartFailed() {
+	store := NewStoreWithDialect(s.db, CockroachDB)
+
+	var calls int
+	err := store.Transaction(func(store *Store) error {
+		calls++
+		if err := store.Insert(ModelSchema, newModel("Joe", "", 1)); err != nil {
+			return err
+		}
+		return &pq.Error{Code: "40001"}
+	})
+	s.Error(err)
+	s.Equal(defaultRestartPolicy.MaxAttempts, calls)
+	s.assertCount(0)
+}
+
+func (s *StoreSuite) TestWithInterceptors() {
+	var stmts []Statement
+	record := func(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+		res := next(ctx, stmt)
+		s.NoError(res.Err)
+		stmts = append(stmts, *stmt)
+		return res
+	}
+
+	store := s.store.WithInterceptors(record)
+	err := store.Transaction(func(store *Store) error {
+		return store.Insert(ModelSchema, newModel("Joe", "", 1))
+	})
+	s.NoError(err)
+
+	_, err = store.Count(NewBaseQuery(ModelSchema))
+	s.NoError(err)
+
+	// the statements are prepared the first time they are run
+	s.Len(stmts, 4)
+	s.Equal(PrepareStatement, stmts[0].Kind)
+	s.Equal(InsertOperation, stmts[0].Operation)
+	s.Equal(QueryRowStatement, stmts[1].Kind)
+	s.Equal(InsertOperation, stmts[1].Operation)
+	s.Equal("model", stmts[1].Table)
+	s.Equal(PrepareStatement, stmts[2].Kind)
+	s.Equal(CountOperation, stmts[3].Operation)
+}
+
+func (s *StoreSuite) TestWithQueryLogger() {
+	var entries []QueryLogEntry
+	logger := QueryLoggerFunc(func(_ context.Context, entry *QueryLogEntry) {
+		entries = append(entries, *entry)
+	})
+
+	store := s.store.WithQueryLogger(logger, QueryLogOptions{
+		RedactedColumns: []string{"email"},
+	})
+	s.NoError(store.Insert(ModelSchema, newModel("Joe", "joe@foo.bar", 1)))
+	s.NoError(store.Insert(ModelSchema, newModel("Jane", "jane@foo.bar", 2)))
+
+	rs, err := store.Find(NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	s.Len(entries, 2)
+	for rs.Next() {
+	}
+	s.NoError(rs.Close())
+
+	_, err = store.RawExec("UPDATE model SET age = age + 1")
+	s.NoError(err)
+
+	s.Len(entries, 4)
+	s.Equal(InsertOperation, entries[0].Operation)
+	s.Equal("model", entries[0].Table)
+	s.Contains(entries[0].Args, RedactedArg)
+	s.NotContains(entries[0].Args, "joe@foo.bar")
+	s.Equal(int64(1), entries[0].RowsReturned)
+
+	s.Equal(FindOperation, entries[2].Operation)
+	s.Equal(int64(2), entries[2].RowsReturned)
+	s.Equal(int64(-1), entries[2].RowsAffected)
+
+	s.Equal(RawOperation, entries[3].Operation)
+	s.Equal(int64(2), entries[3].RowsAffected)
+	s.Equal(int64(-1), entries[3].RowsReturned)
+}
+
+func (s *StoreSuite) TestWithQueryLogger_SlowThreshold() {
+	var entries []QueryLogEntry
+	logger := QueryLoggerFunc(func(_ context.Context, entry *QueryLogEntry) {
+		entries = append(entries, *entry)
+	})
+
+	store := s.store.WithQueryLogger(logger, QueryLogOptions{
+		SlowThreshold: 50 * time.Millisecond,
+	})
+	_, err := store.RawExec("SELECT 1")
+	s.NoError(err)
+	_, err = store.RawExec("SELECT pg_sleep(0.1)")
+	s.NoError(err)
+
+	s.Len(entries, 1)
+	s.Equal("SELECT pg_sleep(0.1)", entries[0].SQL)
+}
+
+func (s *StoreSuite) TestWithMetrics() {
+	sink := newFakeSink()
+	store := s.store.WithMetrics(sink)
+
+	s.NoError(store.Insert(ModelSchema, newModel("Joe", "", 1)))
+	s.NoError(store.Transaction(func(store *Store) error {
+		return store.Insert(ModelSchema, newModel("Jane", "", 2))
+	}))
+	s.Error(store.Transaction(func(store *Store) error {
+		return fmt.Errorf("foo")
+	}))
+
+	rs, err := store.Find(NewBaseQuery(ModelSchema))
+	s.NoError(err)
+	for rs.Next() {
+		_, err := rs.Get(ModelSchema)
+		s.NoError(err)
+	}
+	s.NoError(rs.Close())
+
+	_, err = store.RawExec("SELECT * FROM foo")
+	s.Error(err)
+
+	s.Equal(int64(2), sink.counters["kallax_queries_total,table=model,operation=insert"])
+	s.Equal(int64(1), sink.counters["kallax_queries_total,table=model,operation=find"])
+	s.Equal(int64(2), sink.counters["kallax_rows_scanned_total,table=model"])
+	s.Equal(int64(1), sink.counters["kallax_transactions_total,result=commit"])
+	s.Equal(int64(1), sink.counters["kallax_transactions_total,result=rollback"])
+	s.Equal(int64(1), sink.counters["kallax_query_errors_total,table=,operation=raw,code=42P01"])
+}
+
 func (s *StoreSuite) TestTransaction_CantOpen() {
 	err := s.errStore.Transaction(func(store *Store) error {
 		return nil
@@ -327,6 +715,35 @@ func (s *StoreSuite) TestTransaction_Rollback() {
 	s.assertCount(0)
 }
 
+func (s *StoreSuite) TestTransactionContext_Cancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var called bool
+	err := s.store.TransactionContext(ctx, func(store *Store) error {
+		called = true
+		return nil
+	})
+	s.Error(err)
+	s.False(called, "callback should not be called")
+}
+
+func (s *StoreSuite) TestFindContext_Cancelled() {
+	s.NoError(s.store.Insert(ModelSchema, newModel("Joe", "", 1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.store.FindContext(ctx, NewBaseQuery(ModelSchema))
+	s.Error(err)
+
+	_, err = s.store.CountContext(ctx, NewBaseQuery(ModelSchema))
+	s.Error(err)
+
+	s.Error(s.store.InsertContext(ctx, ModelSchema, newModel("Jane", "", 1)))
+	s.assertCount(1)
+}
+
 func (s *StoreSuite) TestTransaction_RawExec() {
 	err := s.store.Transaction(func(store *Store) error {
 		_, err := store.RawExec("INSERT INTO model (name, email, age) VALUES ($1, $2, $3)", "foo", "bar", 1)
@@ -539,6 +956,314 @@ func (s *StoreSuite) TestFind_1toNMultiple() {
 	s.Equal(100, i)
 }
 
+func (s *StoreSuite) TestFind_Cursor() {
+	for i := 0; i < 25; i++ {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "", i)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(GtOrEq(f("age"), 2))
+	q.Order(Asc(f("age")))
+	q.Offset(1)
+	q.Limit(20)
+	q.BatchSize(7)
+	q.UseCursor()
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	var ages []int
+	for rs.Next() {
+		record, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		s.True(record.IsPersisted())
+		ages = append(ages, record.(*model).Age)
+	}
+	s.NoError(rs.Close())
+	s.Len(ages, 20)
+	s.Equal(3, ages[0])
+	s.Equal(22, ages[19])
+}
+
+func (s *StoreSuite) TestFind_CursorInTransaction() {
+	s.NoError(s.store.Transaction(func(store *Store) error {
+		s.NoError(store.Insert(ModelSchema, newModel("Joe", "", 1)))
+
+		q := NewBaseQuery(ModelSchema)
+		q.UseCursor()
+		rs, err := store.Find(q)
+		s.NoError(err)
+
+		s.True(rs.Next())
+		record, err := rs.Get(ModelSchema)
+		s.NoError(err)
+		s.Equal("Joe", record.(*model).Name)
+		return rs.Close()
+	}))
+	s.assertCount(1)
+}
+
+func (s *StoreSuite) TestFind_Cursor1toN() {
+	rels := []string{"foo", "bar", "baz"}
+	for i := 0; i < 30; i++ {
+		m := newModel(fmt.Sprint(i), fmt.Sprint(i), i)
+		s.NoError(s.store.Insert(ModelSchema, m))
+
+		for _, v := range rels {
+			s.NoError(s.store.Insert(RelSchema, newRel(m.GetID(), fmt.Sprintf("%s%d", v, i))))
+		}
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Order(Asc(f("age")))
+	q.BatchSize(8)
+	q.UseCursor()
+	s.NoError(q.AddRelation(RelSchema, "rels", OneToMany, nil))
+	rs, err := s.store.Find(q)
+	s.NoError(err)
+
+	var i int
+	for rs.Next() {
+		record, err := rs.Get(ModelSchema)
+		s.NoError(err, "row #%d", i)
+		s.Equal(fmt.Sprint(i), record.(*model).Name, "row #%d", i)
+		s.Len(record.(*model).Rels, 3, "row #%d", i)
+		i++
+	}
+	s.NoError(rs.Close())
+	s.Equal(30, i)
+}
+
+func (s *StoreSuite) TestFind_Pagination() {
+	for i := 0; i < 7; i++ {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "", i/2)))
+	}
+
+	page := func(q *BaseQuery) ([]string, PageCursor, PageCursor) {
+		rs, err := s.store.Find(q)
+		s.Require().NoError(err)
+
+		var names []string
+		var first PageCursor
+		for rs.Next() {
+			record, err := rs.Get(ModelSchema)
+			s.Require().NoError(err)
+			names = append(names, record.(*model).Name)
+			if first == "" {
+				first, err = rs.(PageCursorResultSet).Cursor()
+				s.Require().NoError(err)
+			}
+		}
+
+		last, err := rs.(PageCursorResultSet).Cursor()
+		s.Require().NoError(err)
+		s.NoError(rs.Close())
+		return names, first, last
+	}
+
+	newQuery := func() *BaseQuery {
+		q := NewBaseQuery(ModelSchema)
+		q.Order(Asc(f("age")), Asc(f("id")))
+		q.Limit(3)
+		return q
+	}
+
+	names, _, last := page(newQuery())
+	s.Equal([]string{"0", "1", "2"}, names)
+
+	q := newQuery()
+	q.After(last)
+	names, first, last := page(q)
+	s.Equal([]string{"3", "4", "5"}, names)
+
+	q = newQuery()
+	q.After(last)
+	names, _, _ = page(q)
+	s.Equal([]string{"6"}, names)
+
+	q = newQuery()
+	q.Before(first)
+	names, _, _ = page(q)
+	s.Equal([]string{"0", "1", "2"}, names)
+
+	q = newQuery()
+	q.Limit(2)
+	q.Before(first)
+	names, _, _ = page(q)
+	s.Equal([]string{"1", "2"}, names)
+
+	q = newQuery()
+	q.After("foo")
+	_, err := s.store.Find(q)
+	s.Equal(ErrInvalidPageCursor, err)
+}
+
+func (s *StoreSuite) TestAggregate() {
+	for i, email := range []string{"a", "a", "b", "b", "b", "c"} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), email, i)))
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Neq(f("email"), "c"))
+	q.GroupBy(f("email"))
+	q.Order(Asc(f("email")))
+	rows, err := s.store.Aggregate(q, Sum(f("age")), Max(f("age")), CountDistinct(f("name")).As("names"))
+	s.Require().NoError(err)
+	s.Require().Len(rows, 2)
+
+	s.Equal("a", rows[0].String(f("email")))
+	sum, err := rows[0].Int64(Sum(f("age")))
+	s.NoError(err)
+	s.Equal(int64(1), sum)
+
+	s.Equal("b", rows[1].String(f("email")))
+	sum, err = rows[1].Int64(Sum(f("age")))
+	s.NoError(err)
+	s.Equal(int64(9), sum)
+	max, err := rows[1].Int64(Max(f("age")))
+	s.NoError(err)
+	s.Equal(int64(4), max)
+	names, err := rows[1].Int64(f("names"))
+	s.NoError(err)
+	s.Equal(int64(3), names)
+
+	q.Having(Gt(Sum(f("age")), 1))
+	rows, err = s.store.Aggregate(q, Sum(f("age")))
+	s.NoError(err)
+	s.Len(rows, 1)
+}
+
+func (s *StoreSuite) TestAggregateInto() {
+	for i, email := range []string{"a", "a", "b"} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), email, i+1)))
+	}
+
+	var result []struct {
+		Email  string
+		SumAge int64
+		Avg    float64 `kallax:"avg_age"`
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.GroupBy(f("email"))
+	q.Order(Desc(Avg(f("age"))))
+	s.NoError(s.store.AggregateInto(q, &result, Sum(f("age")), Avg(f("age"))))
+	s.Require().Len(result, 2)
+	s.Equal("b", result[0].Email)
+	s.Equal(int64(3), result[0].SumAge)
+	s.Equal(3.0, result[0].Avg)
+	s.Equal("a", result[1].Email)
+	s.Equal(int64(3), result[1].SumAge)
+	s.Equal(1.5, result[1].Avg)
+
+	var invalid []struct{ Email string }
+	s.Error(s.store.AggregateInto(q, &invalid, Sum(f("age"))))
+}
+
+func (s *StoreSuite) TestAggregateValue() {
+	q := NewBaseQuery(ModelSchema)
+	max := -1
+	s.NoError(s.store.AggregateValue(q, Max(f("age")), &max))
+	s.Equal(-1, max)
+
+	for i := 0; i < 3; i++ {
+		s.NoError(s.store.Insert(ModelSchema, newModel(fmt.Sprint(i), "", i)))
+	}
+
+	s.NoError(s.store.AggregateValue(q, Max(f("age")), &max))
+	s.Equal(2, max)
+
+	var avg float64
+	s.NoError(s.store.AggregateValue(q, Avg(f("age")), &avg))
+	s.Equal(1.0, avg)
+}
+
+func (s *StoreSuite) TestFindInto() {
+	for i, name := range []string{"a", "b", "c"} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(name, name+"@foo.com", i)))
+	}
+
+	var result []struct {
+		Name    string
+		Age     int
+		Contact string `kallax:"contact"`
+	}
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"), f("age"), NewExpression("contact", ":col: || ' <' || :col: || '>'", f("name"), f("email")))
+	q.Where(Gt(f("age"), 0))
+	q.Order(Desc(f("age")))
+	s.NoError(s.store.FindInto(q, &result))
+	s.Require().Len(result, 2)
+	s.Equal("c", result[0].Name)
+	s.Equal(2, result[0].Age)
+	s.Equal("c <c@foo.com>", result[0].Contact)
+	s.Equal("b", result[1].Name)
+	s.Equal(1, result[1].Age)
+	s.Equal("b <b@foo.com>", result[1].Contact)
+}
+
+func (s *StoreSuite) TestFind_ForUpdateSkipLocked() {
+	for _, name := range []string{"a", "b", "c"} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(name, "", 1)))
+	}
+
+	newQuery := func() *BaseQuery {
+		q := NewBaseQuery(ModelSchema)
+		q.Order(Asc(f("name")))
+		q.Limit(1)
+		q.SkipLocked()
+		return q
+	}
+
+	_, err := s.store.Find(newQuery())
+	s.Equal(ErrLockOutsideTransaction, err)
+
+	s.NoError(s.store.Transaction(func(tx1 *Store) error {
+		rs, err := tx1.Find(newQuery())
+		s.Require().NoError(err)
+		s.Require().True(rs.Next())
+		record, err := rs.Get(ModelSchema)
+		s.Require().NoError(err)
+		s.Equal("a", record.(*model).Name)
+		s.NoError(rs.Close())
+
+		return s.store.Transaction(func(tx2 *Store) error {
+			rs, err := tx2.Find(newQuery())
+			s.Require().NoError(err)
+			s.Require().True(rs.Next())
+			record, err := rs.Get(ModelSchema)
+			s.Require().NoError(err)
+			s.Equal("b", record.(*model).Name)
+			return rs.Close()
+		})
+	}))
+}
+
+func (s *StoreSuite) TestFind_Subquery() {
+	for _, name := range []string{"a", "b", "c"} {
+		s.NoError(s.store.Insert(ModelSchema, newModel(name, "", 1)))
+	}
+
+	sub := NewBaseQuery(ModelSchema)
+	sub.Select(f("id"))
+	sub.Where(Neq(f("name"), "b"))
+
+	q := NewBaseQuery(ModelSchema)
+	q.Where(Neq(f("name"), "a"))
+	q.Where(InQuery(f("id"), sub))
+	rs, err := s.store.Find(q)
+	s.Require().NoError(err)
+
+	var names []string
+	for rs.Next() {
+		record, err := rs.Get(ModelSchema)
+		s.Require().NoError(err)
+		names = append(names, record.(*model).Name)
+	}
+	s.NoError(rs.Close())
+	s.Equal([]string{"c"}, names)
+}
+
 func (s *StoreSuite) assertModel(m *model) {
 	var result model
 	err := s.db.QueryRow("SELECT id, name, email, age FROM model WHERE id = $1", m.GetID()).
@@ -591,3 +1316,20 @@ func TestStoreFrom(t *testing.T) {
 	StoreFrom(&s2, s1)
 	require.Exactly(s1.Store, s2.Store)
 }
+
+func TestTxOptions(t *testing.T) {
+	require := require.New(t)
+
+	opts := &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	result, setup := txOptions(opts)
+	require.Equal(opts, result)
+	require.Len(setup, 0)
+
+	result, setup = txOptions(nil)
+	require.Nil(result)
+	require.Len(setup, 0)
+
+	result, setup = txOptions(&sql.TxOptions{Isolation: LevelSerializableDeferrable, ReadOnly: true})
+	require.Equal(&sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}, result)
+	require.Equal([]string{"SET TRANSACTION DEFERRABLE"}, setup)
+}
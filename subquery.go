@@ -0,0 +1,145 @@
+package kallax
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lann/builder"
+)
+
+// InQuery returns a condition that will be true when `col` is equal to any of
+// the values selected by the given query, which must select a single column.
+//
+//	q.Where(InQuery(IDColumn, petQuery))
+//	// ... WHERE id IN (SELECT ... FROM pet WHERE ...)
+func InQuery(col SchemaField, q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return &subquery{op: col.QualifiedName(schema) + " IN", q: q, dialect: dialectOf(schema)}
+	}
+}
+
+// NotInQuery returns a condition that will be true when `col` is distinct to
+// all of the values selected by the given query, which must select a single
+// column.
+func NotInQuery(col SchemaField, q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return &subquery{op: col.QualifiedName(schema) + " NOT IN", q: q, dialect: dialectOf(schema)}
+	}
+}
+
+// Exists returns a condition that will be true when the given query selects
+// any row. The query can reference the rows of the query the condition is
+// added to with Correlated conditions.
+//
+//	q.Where(Exists(petQuery))
+//	// ... WHERE EXISTS (SELECT 1 FROM pet WHERE ...)
+func Exists(q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return &subquery{op: "EXISTS", q: q, dialect: dialectOf(schema), exists: true}
+	}
+}
+
+// NotExists returns a condition that will be true when the given query does
+// not select any row. See Exists for more details.
+func NotExists(q Query) Condition {
+	return func(schema Schema) ToSqler {
+		return &subquery{op: "NOT EXISTS", q: q, dialect: dialectOf(schema), exists: true}
+	}
+}
+
+// Correlated returns a condition that will be true when `col` is equal to
+// `outerCol` of the current row of the query with the given schema, which the
+// query the condition is added to is a subquery of. The subquery and the
+// outer query must be of different schemas, as their aliases would collide.
+//
+//	petQuery.Where(Correlated(OwnerIDColumn, UserSchema, IDColumn))
+//	q.Where(Exists(petQuery))
+//	// ... WHERE EXISTS (SELECT 1 FROM pet WHERE pet.owner_id = user.id)
+func Correlated(col SchemaField, outer Schema, outerCol SchemaField) Condition {
+	return func(schema Schema) ToSqler {
+		return squirrel.Expr(fmt.Sprintf(
+			"%s = %s",
+			col.QualifiedName(schema),
+			outerCol.QualifiedName(outer),
+		))
+	}
+}
+
+// ExistsRelated returns a condition that will be true when any of the rows
+// selected by the given query is related to the row of the query the
+// condition is added to through the relationship in the given field of its
+// schema, e.g. when a user has any of the pets selected by a query. The query
+// must be of a different schema, as their aliases would collide.
+func ExistsRelated(field string, q Query) Condition {
+	return func(schema Schema) ToSqler {
+		fk, ok := schema.ForeignKey(field)
+		if !ok {
+			return errOp{fmt.Errorf(
+				"kallax: cannot find foreign key to relate tables %s and %s",
+				schema.Table(), q.Schema().Table(),
+			)}
+		}
+
+		related := q.Schema()
+		if related.Alias() == schema.Alias() {
+			return errOp{fmt.Errorf(
+				"kallax: cannot relate a subquery of table %s with the same alias",
+				related.Table(),
+			)}
+		}
+
+		fkCol := fk.QualifiedName(related)
+		idCol := schema.ID().QualifiedName(schema)
+		if fk.Inverse {
+			fkCol = related.ID().QualifiedName(related)
+			idCol = fk.QualifiedName(schema)
+		}
+
+		return &subquery{
+			op:      "EXISTS",
+			q:       q,
+			dialect: dialectOf(schema),
+			exists:  true,
+			where:   fmt.Sprintf("%s = %s", fkCol, idCol),
+		}
+	}
+}
+
+// subquery is a condition on the results of a query, which is embedded in
+// the condition after the given SQL operator.
+type subquery struct {
+	op      string
+	q       Query
+	dialect Dialect
+	// exists reports whether only the existence of rows is checked, so no
+	// columns need to be selected.
+	exists bool
+	// where is an additional condition of the query, if any.
+	where string
+}
+
+func (s *subquery) ToSql() (string, []interface{}, error) {
+	_, b := s.q.compile(s.dialect)
+	if s.exists {
+		b = builder.Set(b, "Columns", nil).(squirrel.SelectBuilder).Column("1")
+	}
+
+	if s.where != "" {
+		b = b.Where(s.where)
+	}
+
+	if offset := s.q.GetOffset(); offset > 0 {
+		b = b.Offset(offset)
+	}
+
+	if limit := s.q.GetLimit(); limit > 0 {
+		b = b.Limit(limit)
+	}
+
+	// the placeholders are replaced along with the ones of the outer query
+	sql, args, err := b.PlaceholderFormat(squirrel.Question).ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("%s (%s)", s.op, sql), args, nil
+}
@@ -0,0 +1,105 @@
+package kallax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubquery(t *testing.T) {
+	newSubquery := func() *BaseQuery {
+		sub := NewBaseQuery(RelSchema)
+		sub.Select(f("model_id"))
+		sub.Where(Eq(f("foo"), "bar"))
+		return sub
+	}
+
+	limited := newSubquery()
+	limited.Limit(2)
+
+	correlated := newSubquery()
+	correlated.Where(Correlated(f("model_id"), ModelSchema, f("id")))
+
+	cases := []struct {
+		name string
+		cond Condition
+		sql  string
+	}{
+		{
+			"InQuery",
+			InQuery(f("id"), newSubquery()),
+			"__model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = $2)",
+		},
+		{
+			"NotInQuery",
+			NotInQuery(f("id"), limited),
+			"__model.id NOT IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = $2 LIMIT 2)",
+		},
+		{
+			"Exists",
+			Exists(correlated),
+			"EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $2 AND __rel.model_id = __model.id)",
+		},
+		{
+			"NotExists",
+			NotExists(newSubquery()),
+			"NOT EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $2)",
+		},
+		{
+			"ExistsRelated",
+			ExistsRelated("rels", newSubquery()),
+			"EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $2 AND __rel.model_id = __model.id)",
+		},
+		{
+			"ExistsRelated inverse",
+			ExistsRelated("rel_inv", newSubquery()),
+			"EXISTS (SELECT 1 FROM rel __rel WHERE __rel.foo = $2 AND __rel.id = __model.model_id)",
+		},
+	}
+
+	for _, c := range cases {
+		q := NewBaseQuery(ModelSchema)
+		q.Select(f("name"))
+		q.Where(Eq(f("age"), 1))
+		q.Where(c.cond)
+		q.Where(Eq(f("email"), "foo"))
+
+		_, builder := q.compile(PostgreSQL)
+		sql, args, err := builder.ToSql()
+		require.NoError(t, err, c.name)
+		require.Equal(t,
+			"SELECT __model.name FROM model __model WHERE __model.age = $1 AND "+c.sql+" AND __model.email = $3",
+			sql, c.name,
+		)
+		require.Equal(t, []interface{}{1, "bar", "foo"}, args, c.name)
+	}
+}
+
+func TestSubquery_Dialect(t *testing.T) {
+	sub := NewBaseQuery(RelSchema)
+	sub.Select(f("model_id"))
+	sub.Where(Eq(f("foo"), "bar"))
+
+	q := NewBaseQuery(ModelSchema)
+	q.Select(f("name"))
+	q.Where(InQuery(f("id"), sub))
+
+	_, builder := q.compile(MySQL)
+	sql, _, err := builder.ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT __model.name FROM model __model WHERE __model.id IN (SELECT __rel.model_id FROM rel __rel WHERE __rel.foo = ?)", sql)
+}
+
+func TestExistsRelated_Invalid(t *testing.T) {
+	for _, cond := range []Condition{
+		ExistsRelated("foo", NewBaseQuery(RelSchema)),
+		ExistsRelated("rels", NewBaseQuery(ModelSchema)),
+	} {
+		q := NewBaseQuery(ModelSchema)
+		q.Where(cond)
+
+		_, builder := q.compile(PostgreSQL)
+		_, _, err := builder.ToSql()
+		require.Error(t, err)
+	}
+}
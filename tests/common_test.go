@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 var (
@@ -15,6 +16,10 @@ var (
 	database         = envOrDefault("DBNAME", "testing")
 	user             = envOrDefault("DBUSER", "testing")
 	password         = envOrDefault("DBPASS", "testing")
+	// dialect is the dialect of the stores of the tests, which is set with
+	// the DBDIALECT environment variable, so they can also be run against
+	// CockroachDB.
+	dialect = testDialect()
 )
 
 type BaseTestSuite struct {
@@ -113,6 +118,13 @@ func (s *BaseTestSuite) resultOrError(res interface{}, err error) bool {
 	return true
 }
 
+func testDialect() kallax.Dialect {
+	if os.Getenv("DBDIALECT") == kallax.CockroachDB.Name() {
+		return kallax.CockroachDB
+	}
+	return kallax.PostgreSQL
+}
+
 func envOrDefault(key string, def string) string {
 	v := os.Getenv(key)
 	if v == "" {
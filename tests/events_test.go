@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/src-d/go-kallax.v1"
 )
 
 type EventsSuite struct {
@@ -38,7 +39,7 @@ func (s *EventsSuite) assertEventsPassed(expected eventsCheck, received eventsCh
 }
 
 func (s *EventsSuite) TestEventsInsert() {
-	store := NewEventsFixtureStore(s.db)
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsFixture()
 	err := store.Insert(doc)
@@ -49,8 +50,22 @@ func (s *EventsSuite) TestEventsInsert() {
 	}, doc.Checks)
 }
 
+func (s *EventsSuite) TestEventsInsertMany() {
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
+
+	docs := []*EventsFixture{NewEventsFixture(), NewEventsFixture()}
+	s.Nil(store.InsertMany(docs))
+	for _, doc := range docs {
+		s.True(doc.IsPersisted())
+		s.assertEventsPassed(map[string]bool{
+			"BeforeInsert": true,
+			"AfterInsert":  true,
+		}, doc.Checks)
+	}
+}
+
 func (s *EventsSuite) TestEventsUpdate() {
-	store := NewEventsFixtureStore(s.db)
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsFixture()
 	err := store.Insert(doc)
@@ -67,7 +82,7 @@ func (s *EventsSuite) TestEventsUpdate() {
 }
 
 func (s *EventsSuite) TestEventsUpdateError() {
-	store := NewEventsFixtureStore(s.db)
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsFixture()
 	err := store.Insert(doc)
@@ -85,7 +100,7 @@ func (s *EventsSuite) TestEventsUpdateError() {
 }
 
 func (s *EventsSuite) TestEventsSaveOnInsert() {
-	store := NewEventsFixtureStore(s.db)
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsFixture()
 	updated, err := store.Save(doc)
@@ -98,7 +113,7 @@ func (s *EventsSuite) TestEventsSaveOnInsert() {
 }
 
 func (s *EventsSuite) TestEventsSaveOnUpdate() {
-	store := NewEventsFixtureStore(s.db)
+	store := NewEventsFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsFixture()
 	err := store.Insert(doc)
@@ -114,7 +129,7 @@ func (s *EventsSuite) TestEventsSaveOnUpdate() {
 }
 
 func (s *EventsSuite) TestEventsSaveInsert() {
-	store := NewEventsSaveFixtureStore(s.db)
+	store := NewEventsSaveFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsSaveFixture()
 	err := store.Insert(doc)
@@ -126,7 +141,7 @@ func (s *EventsSuite) TestEventsSaveInsert() {
 }
 
 func (s *EventsSuite) TestEventsSaveUpdate() {
-	store := NewEventsSaveFixtureStore(s.db)
+	store := NewEventsSaveFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsSaveFixture()
 	err := store.Insert(doc)
@@ -143,7 +158,7 @@ func (s *EventsSuite) TestEventsSaveUpdate() {
 }
 
 func (s *EventsSuite) TestEventsSaveSave() {
-	store := NewEventsSaveFixtureStore(s.db)
+	store := NewEventsSaveFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsSaveFixture()
 	err := store.Insert(doc)
@@ -160,7 +175,7 @@ func (s *EventsSuite) TestEventsSaveSave() {
 }
 
 func (s *EventsSuite) TestEventsAllInsert() {
-	store := NewEventsAllFixtureStore(s.db)
+	store := NewEventsAllFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsAllFixture()
 	err := store.Insert(doc)
@@ -174,7 +189,7 @@ func (s *EventsSuite) TestEventsAllInsert() {
 }
 
 func (s *EventsSuite) TestEventsAllUpdate() {
-	store := NewEventsAllFixtureStore(s.db)
+	store := NewEventsAllFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsAllFixture()
 	err := store.Insert(doc)
@@ -193,7 +208,7 @@ func (s *EventsSuite) TestEventsAllUpdate() {
 }
 
 func (s *EventsSuite) TestEventsAllSave() {
-	store := NewEventsAllFixtureStore(s.db)
+	store := NewEventsAllFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewEventsAllFixture()
 	err := store.Insert(doc)
@@ -217,3 +232,16 @@ func (s *EventsSuite) TestEventsAllSave() {
 		"AfterSave":    true,
 	}, doc.Checks)
 }
+
+func (s *EventsSuite) TestEventsAllUpsert() {
+	store := NewEventsAllFixtureStoreWithDialect(s.db, dialect)
+
+	doc := NewEventsAllFixture()
+	err := store.Upsert(doc, []kallax.SchemaField{Schema.EventsAllFixture.ID}, Schema.EventsAllFixture.Checks)
+	s.Nil(err)
+	s.True(doc.IsPersisted())
+	s.assertEventsPassed(map[string]bool{
+		"BeforeSave": true,
+		"AfterSave":  true,
+	}, doc.Checks)
+}
@@ -49,7 +49,7 @@ func (s *JSONSuite) TestSearchByCustomField() {
 
 func (s *JSONSuite) assertFound(q *JSONModelQuery, foos ...string) {
 	require := s.Require()
-	store := NewJSONModelStore(s.db)
+	store := NewJSONModelStoreWithDialect(s.db, dialect)
 	rs, err := store.Find(q)
 	require.NoError(err)
 
@@ -62,7 +62,7 @@ func (s *JSONSuite) assertFound(q *JSONModelQuery, foos ...string) {
 }
 
 func (s *JSONSuite) insertFixtures() {
-	store := NewJSONModelStore(s.db)
+	store := NewJSONModelStoreWithDialect(s.db, dialect)
 
 	m := NewJSONModel()
 	m.Foo = "1"
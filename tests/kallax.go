@@ -5,8 +5,10 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
@@ -99,6 +101,12 @@ func NewCarStore(db *sql.DB) *CarStore {
 	return &CarStore{kallax.NewStore(db)}
 }
 
+// NewCarStoreWithDialect creates a new instance of CarStore
+// using a SQL database of the given dialect.
+func NewCarStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *CarStore {
+	return &CarStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *CarStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -121,6 +129,36 @@ func (s *CarStore) DebugWith(logger kallax.LoggerFunc) *CarStore {
 	return &CarStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *CarStore) WithInterceptors(interceptors ...kallax.Interceptor) *CarStore {
+	return &CarStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *CarStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *CarStore {
+	return &CarStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *CarStore) WithTracer(tracer kallax.Tracer) *CarStore {
+	return &CarStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *CarStore) WithMetrics(sink kallax.MetricsSink) *CarStore {
+	return &CarStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *CarStore) WithRetry(policy kallax.RetryPolicy) *CarStore {
+	return &CarStore{s.Store.WithRetry(policy)}
+}
+
 func (s *CarStore) inverseRecords(record *Car) []kallax.RecordWithSchema {
 	record.ClearVirtualColumns()
 	var records []kallax.RecordWithSchema
@@ -139,6 +177,12 @@ func (s *CarStore) inverseRecords(record *Car) []kallax.RecordWithSchema {
 // Insert inserts a Car in the database. A non-persisted object is
 // required for this operation.
 func (s *CarStore) Insert(record *Car) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Car in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *CarStore) InsertContext(ctx context.Context, record *Car) error {
 
 	if err := record.BeforeSave(); err != nil {
 		return err
@@ -147,7 +191,7 @@ func (s *CarStore) Insert(record *Car) error {
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -155,7 +199,7 @@ func (s *CarStore) Insert(record *Car) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -164,7 +208,7 @@ func (s *CarStore) Insert(record *Car) error {
 				}
 			}
 
-			if err := s.Insert(Schema.Car.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.Car.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -176,8 +220,8 @@ func (s *CarStore) Insert(record *Car) error {
 		})
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Car.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Car.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -190,6 +234,96 @@ func (s *CarStore) Insert(record *Car) error {
 
 }
 
+// InsertMany inserts the given Car records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *CarStore) InsertMany(records []*Car) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given Car records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *CarStore) InsertManyContext(ctx context.Context, records []*Car) error {
+
+	var inverseRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		if err := record.BeforeSave(); err != nil {
+			return err
+		}
+
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		for _, r := range inverseRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InsertManyContext(ctx, Schema.Car.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the Car records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *CarStore) CopyFrom(records <-chan *Car) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Car records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *CarStore) CopyFromContext(ctx context.Context, records <-chan *Car) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Car.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Car
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeSave(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -197,6 +331,12 @@ func (s *CarStore) Insert(record *Car) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *CarStore) UpdateContext(ctx context.Context, record *Car, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	if err := record.BeforeSave(); err != nil {
 		return 0, err
@@ -205,7 +345,7 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -213,7 +353,7 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -222,7 +362,7 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 				}
 			}
 
-			updated, err = s.Update(Schema.Car.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.Car.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -240,8 +380,8 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 		return updated, nil
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Car.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Car.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -263,11 +403,18 @@ func (s *CarStore) Update(record *Car, cols ...kallax.SchemaField) (updated int6
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *CarStore) Save(record *Car) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *CarStore) SaveContext(ctx context.Context, record *Car) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -275,15 +422,54 @@ func (s *CarStore) Save(record *Car) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *CarStore) Upsert(record *Car, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *CarStore) UpsertContext(ctx context.Context, record *Car, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Car.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		if !record.IsPersisted() {
+			return nil
+		}
+
+		return record.AfterSave()
+	})
+
+}
+
 // Delete removes the given record from the database.
 func (s *CarStore) Delete(record *Car) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *CarStore) DeleteContext(ctx context.Context, record *Car) error {
 
 	if err := record.BeforeDelete(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Car.BaseSchema, record)
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Car.BaseSchema, record)
 		if err != nil {
 			return err
 		}
@@ -293,9 +479,41 @@ func (s *CarStore) Delete(record *Car) error {
 
 }
 
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *CarStore) UpdateAll(q *CarQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *CarStore) UpdateAllContext(ctx context.Context, q *CarQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *CarStore) DeleteAll(q *CarQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *CarStore) DeleteAllContext(ctx context.Context, q *CarQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
 // Find returns the set of results for the given query.
 func (s *CarStore) Find(q *CarQuery) (*CarResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *CarStore) FindContext(ctx context.Context, q *CarQuery) (*CarResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -309,24 +527,74 @@ func (s *CarStore) MustFind(q *CarQuery) *CarResultSet {
 	return NewCarResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Car records.
+func (s *CarStore) FindInto(q *CarQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *CarStore) FindIntoContext(ctx context.Context, q *CarQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *CarStore) Count(q *CarQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *CarStore) CountContext(ctx context.Context, q *CarQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *CarStore) MustCount(q *CarQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *CarStore) Aggregate(q *CarQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *CarStore) AggregateContext(ctx context.Context, q *CarQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *CarStore) AggregateInto(q *CarQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *CarStore) AggregateIntoContext(ctx context.Context, q *CarQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *CarStore) FindOne(q *CarQuery) (*Car, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *CarStore) FindOneContext(ctx context.Context, q *CarQuery) (*Car, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -349,7 +617,13 @@ func (s *CarStore) FindOne(q *CarQuery) (*Car, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *CarStore) FindAll(q *CarQuery) ([]*Car, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *CarStore) FindAllContext(ctx context.Context, q *CarQuery) ([]*Car, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -373,16 +647,52 @@ func (s *CarStore) Reload(record *Car) error {
 	return s.Store.Reload(Schema.Car.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Car with the data in the database using
+// the given context and makes it writable.
+func (s *CarStore) ReloadContext(ctx context.Context, record *Car) error {
+	return s.Store.ReloadContext(ctx, Schema.Car.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *CarStore) Transaction(callback func(*CarStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *CarStore) TransactionContext(ctx context.Context, callback func(*CarStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&CarStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *CarStore) TransactionWith(opts *sql.TxOptions, callback func(*CarStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *CarStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*CarStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&CarStore{store})
 	})
 }
@@ -436,6 +746,13 @@ func (q *CarQuery) BatchSize(size uint64) *CarQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *CarQuery) UseCursor() *CarQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *CarQuery) Limit(n uint64) *CarQuery {
 	q.BaseQuery.Limit(n)
@@ -448,6 +765,20 @@ func (q *CarQuery) Offset(n uint64) *CarQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *CarQuery) After(cursor kallax.PageCursor) *CarQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *CarQuery) Before(cursor kallax.PageCursor) *CarQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *CarQuery) Where(cond kallax.Condition) *CarQuery {
@@ -455,11 +786,70 @@ func (q *CarQuery) Where(cond kallax.Condition) *CarQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *CarQuery) GroupBy(fields ...kallax.SchemaField) *CarQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *CarQuery) Having(cond kallax.Condition) *CarQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *CarQuery) ForUpdate() *CarQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *CarQuery) ForShare() *CarQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *CarQuery) SkipLocked() *CarQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *CarQuery) NoWait() *CarQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *CarQuery) Of(schemas ...kallax.Schema) *CarQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *CarQuery) WithOwner() *CarQuery {
 	q.AddRelation(Schema.Person.BaseSchema, "Owner", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereOwnerExist adds a condition to the query that requires that any of
+// the Owner of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *CarQuery) WhereOwnerExist(sub *PersonQuery) *CarQuery {
+	if sub == nil {
+		sub = NewPersonQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Owner", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -589,6 +979,18 @@ func (rs *CarResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Car retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *CarResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *CarResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -661,6 +1063,12 @@ func NewEventsAllFixtureStore(db *sql.DB) *EventsAllFixtureStore {
 	return &EventsAllFixtureStore{kallax.NewStore(db)}
 }
 
+// NewEventsAllFixtureStoreWithDialect creates a new instance of EventsAllFixtureStore
+// using a SQL database of the given dialect.
+func NewEventsAllFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *EventsAllFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -683,9 +1091,45 @@ func (s *EventsAllFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsAllFi
 	return &EventsAllFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *EventsAllFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *EventsAllFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *EventsAllFixtureStore) WithTracer(tracer kallax.Tracer) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *EventsAllFixtureStore) WithMetrics(sink kallax.MetricsSink) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *EventsAllFixtureStore) WithRetry(policy kallax.RetryPolicy) *EventsAllFixtureStore {
+	return &EventsAllFixtureStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a EventsAllFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a EventsAllFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *EventsAllFixtureStore) InsertContext(ctx context.Context, record *EventsAllFixture) error {
 
 	if err := record.BeforeSave(); err != nil {
 		return err
@@ -695,8 +1139,8 @@ func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsAllFixture.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsAllFixture.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -713,6 +1157,89 @@ func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
 
 }
 
+// InsertMany inserts the given EventsAllFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *EventsAllFixtureStore) InsertMany(records []*EventsAllFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given EventsAllFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *EventsAllFixtureStore) InsertManyContext(ctx context.Context, records []*EventsAllFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		if err := record.BeforeSave(); err != nil {
+			return err
+		}
+
+		if err := record.BeforeInsert(); err != nil {
+			return err
+		}
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.EventsAllFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterInsert(); err != nil {
+				return err
+			}
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the EventsAllFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *EventsAllFixtureStore) CopyFrom(records <-chan *EventsAllFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the EventsAllFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *EventsAllFixtureStore) CopyFromContext(ctx context.Context, records <-chan *EventsAllFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.EventsAllFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *EventsAllFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeSave(); err != nil {
+			return nil, err
+		}
+
+		if err := record.BeforeInsert(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -720,6 +1247,12 @@ func (s *EventsAllFixtureStore) Insert(record *EventsAllFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *EventsAllFixtureStore) Update(record *EventsAllFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *EventsAllFixtureStore) UpdateContext(ctx context.Context, record *EventsAllFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	if err := record.BeforeSave(); err != nil {
 		return 0, err
@@ -729,8 +1262,8 @@ func (s *EventsAllFixtureStore) Update(record *EventsAllFixture, cols ...kallax.
 		return 0, err
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsAllFixture.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsAllFixture.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -756,11 +1289,18 @@ func (s *EventsAllFixtureStore) Update(record *EventsAllFixture, cols ...kallax.
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *EventsAllFixtureStore) Save(record *EventsAllFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *EventsAllFixtureStore) SaveContext(ctx context.Context, record *EventsAllFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -768,47 +1308,168 @@ func (s *EventsAllFixtureStore) Save(record *EventsAllFixture) (updated bool, er
 	return rowsUpdated > 0, nil
 }
 
-// Delete removes the given record from the database.
-func (s *EventsAllFixtureStore) Delete(record *EventsAllFixture) error {
-
-	return s.Store.Delete(Schema.EventsAllFixture.BaseSchema, record)
-
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *EventsAllFixtureStore) Upsert(record *EventsAllFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
 }
 
-// Find returns the set of results for the given query.
-func (s *EventsAllFixtureStore) Find(q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *EventsAllFixtureStore) UpsertContext(ctx context.Context, record *EventsAllFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	if err := record.BeforeSave(); err != nil {
+		return err
 	}
 
-	return NewEventsAllFixtureResultSet(rs), nil
-}
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.EventsAllFixture.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
 
-// MustFind returns the set of results for the given query, but panics if there
-// is any error.
-func (s *EventsAllFixtureStore) MustFind(q *EventsAllFixtureQuery) *EventsAllFixtureResultSet {
-	return NewEventsAllFixtureResultSet(s.Store.MustFind(q))
-}
+		if !record.IsPersisted() {
+			return nil
+		}
 
-// Count returns the number of rows that would be retrieved with the given
+		return record.AfterSave()
+	})
+
+}
+
+// Delete removes the given record from the database.
+func (s *EventsAllFixtureStore) Delete(record *EventsAllFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *EventsAllFixtureStore) DeleteContext(ctx context.Context, record *EventsAllFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.EventsAllFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *EventsAllFixtureStore) UpdateAll(q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *EventsAllFixtureStore) UpdateAllContext(ctx context.Context, q *EventsAllFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *EventsAllFixtureStore) DeleteAll(q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *EventsAllFixtureStore) DeleteAllContext(ctx context.Context, q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
+// Find returns the set of results for the given query.
+func (s *EventsAllFixtureStore) Find(q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *EventsAllFixtureStore) FindContext(ctx context.Context, q *EventsAllFixtureQuery) (*EventsAllFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventsAllFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *EventsAllFixtureStore) MustFind(q *EventsAllFixtureQuery) *EventsAllFixtureResultSet {
+	return NewEventsAllFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as EventsAllFixture records.
+func (s *EventsAllFixtureStore) FindInto(q *EventsAllFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *EventsAllFixtureStore) FindIntoContext(ctx context.Context, q *EventsAllFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
+// Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *EventsAllFixtureStore) Count(q *EventsAllFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *EventsAllFixtureStore) CountContext(ctx context.Context, q *EventsAllFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *EventsAllFixtureStore) MustCount(q *EventsAllFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *EventsAllFixtureStore) Aggregate(q *EventsAllFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *EventsAllFixtureStore) AggregateContext(ctx context.Context, q *EventsAllFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *EventsAllFixtureStore) AggregateInto(q *EventsAllFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *EventsAllFixtureStore) AggregateIntoContext(ctx context.Context, q *EventsAllFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *EventsAllFixtureStore) FindOne(q *EventsAllFixtureQuery) (*EventsAllFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *EventsAllFixtureStore) FindOneContext(ctx context.Context, q *EventsAllFixtureQuery) (*EventsAllFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -831,7 +1492,13 @@ func (s *EventsAllFixtureStore) FindOne(q *EventsAllFixtureQuery) (*EventsAllFix
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *EventsAllFixtureStore) FindAll(q *EventsAllFixtureQuery) ([]*EventsAllFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *EventsAllFixtureStore) FindAllContext(ctx context.Context, q *EventsAllFixtureQuery) ([]*EventsAllFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -855,16 +1522,52 @@ func (s *EventsAllFixtureStore) Reload(record *EventsAllFixture) error {
 	return s.Store.Reload(Schema.EventsAllFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the EventsAllFixture with the data in the database using
+// the given context and makes it writable.
+func (s *EventsAllFixtureStore) ReloadContext(ctx context.Context, record *EventsAllFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsAllFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *EventsAllFixtureStore) Transaction(callback func(*EventsAllFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsAllFixtureStore) TransactionContext(ctx context.Context, callback func(*EventsAllFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&EventsAllFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsAllFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*EventsAllFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsAllFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsAllFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&EventsAllFixtureStore{store})
 	})
 }
@@ -918,6 +1621,13 @@ func (q *EventsAllFixtureQuery) BatchSize(size uint64) *EventsAllFixtureQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *EventsAllFixtureQuery) UseCursor() *EventsAllFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *EventsAllFixtureQuery) Limit(n uint64) *EventsAllFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -930,6 +1640,20 @@ func (q *EventsAllFixtureQuery) Offset(n uint64) *EventsAllFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsAllFixtureQuery) After(cursor kallax.PageCursor) *EventsAllFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsAllFixtureQuery) Before(cursor kallax.PageCursor) *EventsAllFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *EventsAllFixtureQuery) Where(cond kallax.Condition) *EventsAllFixtureQuery {
@@ -937,6 +1661,55 @@ func (q *EventsAllFixtureQuery) Where(cond kallax.Condition) *EventsAllFixtureQu
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *EventsAllFixtureQuery) GroupBy(fields ...kallax.SchemaField) *EventsAllFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *EventsAllFixtureQuery) Having(cond kallax.Condition) *EventsAllFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *EventsAllFixtureQuery) ForUpdate() *EventsAllFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *EventsAllFixtureQuery) ForShare() *EventsAllFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *EventsAllFixtureQuery) SkipLocked() *EventsAllFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *EventsAllFixtureQuery) NoWait() *EventsAllFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *EventsAllFixtureQuery) Of(schemas ...kallax.Schema) *EventsAllFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1054,6 +1827,18 @@ func (rs *EventsAllFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last EventsAllFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *EventsAllFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *EventsAllFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -1126,6 +1911,12 @@ func NewEventsFixtureStore(db *sql.DB) *EventsFixtureStore {
 	return &EventsFixtureStore{kallax.NewStore(db)}
 }
 
+// NewEventsFixtureStoreWithDialect creates a new instance of EventsFixtureStore
+// using a SQL database of the given dialect.
+func NewEventsFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *EventsFixtureStore {
+	return &EventsFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *EventsFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -1148,16 +1939,52 @@ func (s *EventsFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsFixtureS
 	return &EventsFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *EventsFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *EventsFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *EventsFixtureStore) WithTracer(tracer kallax.Tracer) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *EventsFixtureStore) WithMetrics(sink kallax.MetricsSink) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *EventsFixtureStore) WithRetry(policy kallax.RetryPolicy) *EventsFixtureStore {
+	return &EventsFixtureStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a EventsFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a EventsFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *EventsFixtureStore) InsertContext(ctx context.Context, record *EventsFixture) error {
 
 	if err := record.BeforeInsert(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsFixture.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsFixture.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -1170,6 +1997,77 @@ func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
 
 }
 
+// InsertMany inserts the given EventsFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *EventsFixtureStore) InsertMany(records []*EventsFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given EventsFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *EventsFixtureStore) InsertManyContext(ctx context.Context, records []*EventsFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		if err := record.BeforeInsert(); err != nil {
+			return err
+		}
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.EventsFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterInsert(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the EventsFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *EventsFixtureStore) CopyFrom(records <-chan *EventsFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the EventsFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *EventsFixtureStore) CopyFromContext(ctx context.Context, records <-chan *EventsFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.EventsFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *EventsFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeInsert(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -1177,13 +2075,19 @@ func (s *EventsFixtureStore) Insert(record *EventsFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *EventsFixtureStore) Update(record *EventsFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *EventsFixtureStore) UpdateContext(ctx context.Context, record *EventsFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	if err := record.BeforeUpdate(); err != nil {
 		return 0, err
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsFixture.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsFixture.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -1205,11 +2109,18 @@ func (s *EventsFixtureStore) Update(record *EventsFixture, cols ...kallax.Schema
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *EventsFixtureStore) Save(record *EventsFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *EventsFixtureStore) SaveContext(ctx context.Context, record *EventsFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -1217,16 +2128,73 @@ func (s *EventsFixtureStore) Save(record *EventsFixture) (updated bool, err erro
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *EventsFixtureStore) Upsert(record *EventsFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *EventsFixtureStore) UpsertContext(ctx context.Context, record *EventsFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.EventsFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *EventsFixtureStore) Delete(record *EventsFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *EventsFixtureStore) DeleteContext(ctx context.Context, record *EventsFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.EventsFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *EventsFixtureStore) UpdateAll(q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
 
-	return s.Store.Delete(Schema.EventsFixture.BaseSchema, record)
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *EventsFixtureStore) UpdateAllContext(ctx context.Context, q *EventsFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *EventsFixtureStore) DeleteAll(q *EventsFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *EventsFixtureStore) DeleteAllContext(ctx context.Context, q *EventsFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *EventsFixtureStore) Find(q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *EventsFixtureStore) FindContext(ctx context.Context, q *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1240,24 +2208,74 @@ func (s *EventsFixtureStore) MustFind(q *EventsFixtureQuery) *EventsFixtureResul
 	return NewEventsFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as EventsFixture records.
+func (s *EventsFixtureStore) FindInto(q *EventsFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *EventsFixtureStore) FindIntoContext(ctx context.Context, q *EventsFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *EventsFixtureStore) Count(q *EventsFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *EventsFixtureStore) CountContext(ctx context.Context, q *EventsFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *EventsFixtureStore) MustCount(q *EventsFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *EventsFixtureStore) Aggregate(q *EventsFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *EventsFixtureStore) AggregateContext(ctx context.Context, q *EventsFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *EventsFixtureStore) AggregateInto(q *EventsFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *EventsFixtureStore) AggregateIntoContext(ctx context.Context, q *EventsFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *EventsFixtureStore) FindOne(q *EventsFixtureQuery) (*EventsFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *EventsFixtureStore) FindOneContext(ctx context.Context, q *EventsFixtureQuery) (*EventsFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1280,7 +2298,13 @@ func (s *EventsFixtureStore) FindOne(q *EventsFixtureQuery) (*EventsFixture, err
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *EventsFixtureStore) FindAll(q *EventsFixtureQuery) ([]*EventsFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *EventsFixtureStore) FindAllContext(ctx context.Context, q *EventsFixtureQuery) ([]*EventsFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1304,16 +2328,52 @@ func (s *EventsFixtureStore) Reload(record *EventsFixture) error {
 	return s.Store.Reload(Schema.EventsFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the EventsFixture with the data in the database using
+// the given context and makes it writable.
+func (s *EventsFixtureStore) ReloadContext(ctx context.Context, record *EventsFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *EventsFixtureStore) Transaction(callback func(*EventsFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsFixtureStore) TransactionContext(ctx context.Context, callback func(*EventsFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&EventsFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*EventsFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&EventsFixtureStore{store})
 	})
 }
@@ -1367,6 +2427,13 @@ func (q *EventsFixtureQuery) BatchSize(size uint64) *EventsFixtureQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *EventsFixtureQuery) UseCursor() *EventsFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *EventsFixtureQuery) Limit(n uint64) *EventsFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -1379,6 +2446,20 @@ func (q *EventsFixtureQuery) Offset(n uint64) *EventsFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsFixtureQuery) After(cursor kallax.PageCursor) *EventsFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsFixtureQuery) Before(cursor kallax.PageCursor) *EventsFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *EventsFixtureQuery) Where(cond kallax.Condition) *EventsFixtureQuery {
@@ -1386,6 +2467,55 @@ func (q *EventsFixtureQuery) Where(cond kallax.Condition) *EventsFixtureQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *EventsFixtureQuery) GroupBy(fields ...kallax.SchemaField) *EventsFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *EventsFixtureQuery) Having(cond kallax.Condition) *EventsFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *EventsFixtureQuery) ForUpdate() *EventsFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *EventsFixtureQuery) ForShare() *EventsFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *EventsFixtureQuery) SkipLocked() *EventsFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *EventsFixtureQuery) NoWait() *EventsFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *EventsFixtureQuery) Of(schemas ...kallax.Schema) *EventsFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1503,6 +2633,18 @@ func (rs *EventsFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last EventsFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *EventsFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *EventsFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -1575,6 +2717,12 @@ func NewEventsSaveFixtureStore(db *sql.DB) *EventsSaveFixtureStore {
 	return &EventsSaveFixtureStore{kallax.NewStore(db)}
 }
 
+// NewEventsSaveFixtureStoreWithDialect creates a new instance of EventsSaveFixtureStore
+// using a SQL database of the given dialect.
+func NewEventsSaveFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *EventsSaveFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -1597,16 +2745,52 @@ func (s *EventsSaveFixtureStore) DebugWith(logger kallax.LoggerFunc) *EventsSave
 	return &EventsSaveFixtureStore{s.Store.DebugWith(logger)}
 }
 
-// Insert inserts a EventsSaveFixture in the database. A non-persisted object is
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *EventsSaveFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *EventsSaveFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *EventsSaveFixtureStore) WithTracer(tracer kallax.Tracer) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *EventsSaveFixtureStore) WithMetrics(sink kallax.MetricsSink) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *EventsSaveFixtureStore) WithRetry(policy kallax.RetryPolicy) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{s.Store.WithRetry(policy)}
+}
+
+// Insert inserts a EventsSaveFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a EventsSaveFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *EventsSaveFixtureStore) InsertContext(ctx context.Context, record *EventsSaveFixture) error {
 
 	if err := record.BeforeSave(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.EventsSaveFixture.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.EventsSaveFixture.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -1619,6 +2803,77 @@ func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
 
 }
 
+// InsertMany inserts the given EventsSaveFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *EventsSaveFixtureStore) InsertMany(records []*EventsSaveFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given EventsSaveFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *EventsSaveFixtureStore) InsertManyContext(ctx context.Context, records []*EventsSaveFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		if err := record.BeforeSave(); err != nil {
+			return err
+		}
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.EventsSaveFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the EventsSaveFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *EventsSaveFixtureStore) CopyFrom(records <-chan *EventsSaveFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the EventsSaveFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *EventsSaveFixtureStore) CopyFromContext(ctx context.Context, records <-chan *EventsSaveFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.EventsSaveFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *EventsSaveFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeSave(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -1626,13 +2881,19 @@ func (s *EventsSaveFixtureStore) Insert(record *EventsSaveFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *EventsSaveFixtureStore) UpdateContext(ctx context.Context, record *EventsSaveFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	if err := record.BeforeSave(); err != nil {
 		return 0, err
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.EventsSaveFixture.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.EventsSaveFixture.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -1654,11 +2915,18 @@ func (s *EventsSaveFixtureStore) Update(record *EventsSaveFixture, cols ...kalla
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *EventsSaveFixtureStore) Save(record *EventsSaveFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *EventsSaveFixtureStore) SaveContext(ctx context.Context, record *EventsSaveFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -1666,16 +2934,87 @@ func (s *EventsSaveFixtureStore) Save(record *EventsSaveFixture) (updated bool,
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *EventsSaveFixtureStore) Upsert(record *EventsSaveFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *EventsSaveFixtureStore) UpsertContext(ctx context.Context, record *EventsSaveFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.EventsSaveFixture.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		if !record.IsPersisted() {
+			return nil
+		}
+
+		return record.AfterSave()
+	})
+
+}
+
 // Delete removes the given record from the database.
 func (s *EventsSaveFixtureStore) Delete(record *EventsSaveFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *EventsSaveFixtureStore) DeleteContext(ctx context.Context, record *EventsSaveFixture) error {
 
-	return s.Store.Delete(Schema.EventsSaveFixture.BaseSchema, record)
+	return s.Store.DeleteContext(ctx, Schema.EventsSaveFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *EventsSaveFixtureStore) UpdateAll(q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *EventsSaveFixtureStore) UpdateAllContext(ctx context.Context, q *EventsSaveFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *EventsSaveFixtureStore) DeleteAll(q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *EventsSaveFixtureStore) DeleteAllContext(ctx context.Context, q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *EventsSaveFixtureStore) Find(q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *EventsSaveFixtureStore) FindContext(ctx context.Context, q *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1689,24 +3028,74 @@ func (s *EventsSaveFixtureStore) MustFind(q *EventsSaveFixtureQuery) *EventsSave
 	return NewEventsSaveFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as EventsSaveFixture records.
+func (s *EventsSaveFixtureStore) FindInto(q *EventsSaveFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *EventsSaveFixtureStore) FindIntoContext(ctx context.Context, q *EventsSaveFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *EventsSaveFixtureStore) Count(q *EventsSaveFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *EventsSaveFixtureStore) CountContext(ctx context.Context, q *EventsSaveFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *EventsSaveFixtureStore) MustCount(q *EventsSaveFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *EventsSaveFixtureStore) Aggregate(q *EventsSaveFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *EventsSaveFixtureStore) AggregateContext(ctx context.Context, q *EventsSaveFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *EventsSaveFixtureStore) AggregateInto(q *EventsSaveFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *EventsSaveFixtureStore) AggregateIntoContext(ctx context.Context, q *EventsSaveFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *EventsSaveFixtureStore) FindOne(q *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *EventsSaveFixtureStore) FindOneContext(ctx context.Context, q *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1729,7 +3118,13 @@ func (s *EventsSaveFixtureStore) FindOne(q *EventsSaveFixtureQuery) (*EventsSave
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *EventsSaveFixtureStore) FindAll(q *EventsSaveFixtureQuery) ([]*EventsSaveFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *EventsSaveFixtureStore) FindAllContext(ctx context.Context, q *EventsSaveFixtureQuery) ([]*EventsSaveFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1753,16 +3148,52 @@ func (s *EventsSaveFixtureStore) Reload(record *EventsSaveFixture) error {
 	return s.Store.Reload(Schema.EventsSaveFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the EventsSaveFixture with the data in the database using
+// the given context and makes it writable.
+func (s *EventsSaveFixtureStore) ReloadContext(ctx context.Context, record *EventsSaveFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.EventsSaveFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *EventsSaveFixtureStore) Transaction(callback func(*EventsSaveFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsSaveFixtureStore) TransactionContext(ctx context.Context, callback func(*EventsSaveFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&EventsSaveFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsSaveFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*EventsSaveFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *EventsSaveFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*EventsSaveFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&EventsSaveFixtureStore{store})
 	})
 }
@@ -1816,6 +3247,13 @@ func (q *EventsSaveFixtureQuery) BatchSize(size uint64) *EventsSaveFixtureQuery
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *EventsSaveFixtureQuery) UseCursor() *EventsSaveFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *EventsSaveFixtureQuery) Limit(n uint64) *EventsSaveFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -1828,6 +3266,20 @@ func (q *EventsSaveFixtureQuery) Offset(n uint64) *EventsSaveFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsSaveFixtureQuery) After(cursor kallax.PageCursor) *EventsSaveFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *EventsSaveFixtureQuery) Before(cursor kallax.PageCursor) *EventsSaveFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *EventsSaveFixtureQuery) Where(cond kallax.Condition) *EventsSaveFixtureQuery {
@@ -1835,6 +3287,55 @@ func (q *EventsSaveFixtureQuery) Where(cond kallax.Condition) *EventsSaveFixture
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *EventsSaveFixtureQuery) GroupBy(fields ...kallax.SchemaField) *EventsSaveFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *EventsSaveFixtureQuery) Having(cond kallax.Condition) *EventsSaveFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *EventsSaveFixtureQuery) ForUpdate() *EventsSaveFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *EventsSaveFixtureQuery) ForShare() *EventsSaveFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *EventsSaveFixtureQuery) SkipLocked() *EventsSaveFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *EventsSaveFixtureQuery) NoWait() *EventsSaveFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *EventsSaveFixtureQuery) Of(schemas ...kallax.Schema) *EventsSaveFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -1952,6 +3453,18 @@ func (rs *EventsSaveFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last EventsSaveFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *EventsSaveFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *EventsSaveFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -2034,6 +3547,12 @@ func NewJSONModelStore(db *sql.DB) *JSONModelStore {
 	return &JSONModelStore{kallax.NewStore(db)}
 }
 
+// NewJSONModelStoreWithDialect creates a new instance of JSONModelStore
+// using a SQL database of the given dialect.
+func NewJSONModelStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *JSONModelStore {
+	return &JSONModelStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *JSONModelStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -2056,12 +3575,96 @@ func (s *JSONModelStore) DebugWith(logger kallax.LoggerFunc) *JSONModelStore {
 	return &JSONModelStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *JSONModelStore) WithInterceptors(interceptors ...kallax.Interceptor) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *JSONModelStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *JSONModelStore) WithTracer(tracer kallax.Tracer) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *JSONModelStore) WithMetrics(sink kallax.MetricsSink) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *JSONModelStore) WithRetry(policy kallax.RetryPolicy) *JSONModelStore {
+	return &JSONModelStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a JSONModel in the database. A non-persisted object is
 // required for this operation.
 func (s *JSONModelStore) Insert(record *JSONModel) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a JSONModel in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *JSONModelStore) InsertContext(ctx context.Context, record *JSONModel) error {
+
+	return s.Store.InsertContext(ctx, Schema.JSONModel.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given JSONModel records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *JSONModelStore) InsertMany(records []*JSONModel) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given JSONModel records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *JSONModelStore) InsertManyContext(ctx context.Context, records []*JSONModel) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.JSONModel.BaseSchema, kallaxRecords...)
+
+}
 
-	return s.Store.Insert(Schema.JSONModel.BaseSchema, record)
+// CopyFrom inserts the JSONModel records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *JSONModelStore) CopyFrom(records <-chan *JSONModel) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the JSONModel records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *JSONModelStore) CopyFromContext(ctx context.Context, records <-chan *JSONModel) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.JSONModel.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *JSONModel
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -2071,19 +3674,32 @@ func (s *JSONModelStore) Insert(record *JSONModel) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *JSONModelStore) Update(record *JSONModel, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-	return s.Store.Update(Schema.JSONModel.BaseSchema, record, cols...)
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *JSONModelStore) UpdateContext(ctx context.Context, record *JSONModel, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.JSONModel.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *JSONModelStore) Save(record *JSONModel) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *JSONModelStore) SaveContext(ctx context.Context, record *JSONModel) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -2091,16 +3707,73 @@ func (s *JSONModelStore) Save(record *JSONModel) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *JSONModelStore) Upsert(record *JSONModel, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *JSONModelStore) UpsertContext(ctx context.Context, record *JSONModel, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.JSONModel.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *JSONModelStore) Delete(record *JSONModel) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *JSONModelStore) DeleteContext(ctx context.Context, record *JSONModel) error {
+
+	return s.Store.DeleteContext(ctx, Schema.JSONModel.BaseSchema, record)
+
+}
 
-	return s.Store.Delete(Schema.JSONModel.BaseSchema, record)
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *JSONModelStore) UpdateAll(q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *JSONModelStore) UpdateAllContext(ctx context.Context, q *JSONModelQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *JSONModelStore) DeleteAll(q *JSONModelQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *JSONModelStore) DeleteAllContext(ctx context.Context, q *JSONModelQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *JSONModelStore) Find(q *JSONModelQuery) (*JSONModelResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *JSONModelStore) FindContext(ctx context.Context, q *JSONModelQuery) (*JSONModelResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2114,24 +3787,74 @@ func (s *JSONModelStore) MustFind(q *JSONModelQuery) *JSONModelResultSet {
 	return NewJSONModelResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as JSONModel records.
+func (s *JSONModelStore) FindInto(q *JSONModelQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *JSONModelStore) FindIntoContext(ctx context.Context, q *JSONModelQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *JSONModelStore) Count(q *JSONModelQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *JSONModelStore) CountContext(ctx context.Context, q *JSONModelQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *JSONModelStore) MustCount(q *JSONModelQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *JSONModelStore) Aggregate(q *JSONModelQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *JSONModelStore) AggregateContext(ctx context.Context, q *JSONModelQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *JSONModelStore) AggregateInto(q *JSONModelQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *JSONModelStore) AggregateIntoContext(ctx context.Context, q *JSONModelQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *JSONModelStore) FindOne(q *JSONModelQuery) (*JSONModel, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *JSONModelStore) FindOneContext(ctx context.Context, q *JSONModelQuery) (*JSONModel, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2154,7 +3877,13 @@ func (s *JSONModelStore) FindOne(q *JSONModelQuery) (*JSONModel, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *JSONModelStore) FindAll(q *JSONModelQuery) ([]*JSONModel, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *JSONModelStore) FindAllContext(ctx context.Context, q *JSONModelQuery) ([]*JSONModel, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2178,16 +3907,52 @@ func (s *JSONModelStore) Reload(record *JSONModel) error {
 	return s.Store.Reload(Schema.JSONModel.BaseSchema, record)
 }
 
+// ReloadContext refreshes the JSONModel with the data in the database using
+// the given context and makes it writable.
+func (s *JSONModelStore) ReloadContext(ctx context.Context, record *JSONModel) error {
+	return s.Store.ReloadContext(ctx, Schema.JSONModel.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *JSONModelStore) Transaction(callback func(*JSONModelStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *JSONModelStore) TransactionContext(ctx context.Context, callback func(*JSONModelStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&JSONModelStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *JSONModelStore) TransactionWith(opts *sql.TxOptions, callback func(*JSONModelStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *JSONModelStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*JSONModelStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&JSONModelStore{store})
 	})
 }
@@ -2241,6 +4006,13 @@ func (q *JSONModelQuery) BatchSize(size uint64) *JSONModelQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *JSONModelQuery) UseCursor() *JSONModelQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *JSONModelQuery) Limit(n uint64) *JSONModelQuery {
 	q.BaseQuery.Limit(n)
@@ -2253,6 +4025,20 @@ func (q *JSONModelQuery) Offset(n uint64) *JSONModelQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *JSONModelQuery) After(cursor kallax.PageCursor) *JSONModelQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *JSONModelQuery) Before(cursor kallax.PageCursor) *JSONModelQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *JSONModelQuery) Where(cond kallax.Condition) *JSONModelQuery {
@@ -2260,7 +4046,56 @@ func (q *JSONModelQuery) Where(cond kallax.Condition) *JSONModelQuery {
 	return q
 }
 
-// FindByID adds a new filter to the query that will require that
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *JSONModelQuery) GroupBy(fields ...kallax.SchemaField) *JSONModelQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *JSONModelQuery) Having(cond kallax.Condition) *JSONModelQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *JSONModelQuery) ForUpdate() *JSONModelQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *JSONModelQuery) ForShare() *JSONModelQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *JSONModelQuery) SkipLocked() *JSONModelQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *JSONModelQuery) NoWait() *JSONModelQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *JSONModelQuery) Of(schemas ...kallax.Schema) *JSONModelQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
 func (q *JSONModelQuery) FindByID(v ...kallax.ULID) *JSONModelQuery {
@@ -2383,6 +4218,18 @@ func (rs *JSONModelResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last JSONModel retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *JSONModelResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *JSONModelResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -2455,6 +4302,12 @@ func NewMultiKeySortFixtureStore(db *sql.DB) *MultiKeySortFixtureStore {
 	return &MultiKeySortFixtureStore{kallax.NewStore(db)}
 }
 
+// NewMultiKeySortFixtureStoreWithDialect creates a new instance of MultiKeySortFixtureStore
+// using a SQL database of the given dialect.
+func NewMultiKeySortFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *MultiKeySortFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -2477,16 +4330,105 @@ func (s *MultiKeySortFixtureStore) DebugWith(logger kallax.LoggerFunc) *MultiKey
 	return &MultiKeySortFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *MultiKeySortFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *MultiKeySortFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *MultiKeySortFixtureStore) WithTracer(tracer kallax.Tracer) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *MultiKeySortFixtureStore) WithMetrics(sink kallax.MetricsSink) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *MultiKeySortFixtureStore) WithRetry(policy kallax.RetryPolicy) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a MultiKeySortFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *MultiKeySortFixtureStore) Insert(record *MultiKeySortFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a MultiKeySortFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *MultiKeySortFixtureStore) InsertContext(ctx context.Context, record *MultiKeySortFixture) error {
 	record.Start = record.Start.Truncate(time.Microsecond)
 	record.End = record.End.Truncate(time.Microsecond)
 
-	return s.Store.Insert(Schema.MultiKeySortFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given MultiKeySortFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *MultiKeySortFixtureStore) InsertMany(records []*MultiKeySortFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given MultiKeySortFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *MultiKeySortFixtureStore) InsertManyContext(ctx context.Context, records []*MultiKeySortFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.Start = record.Start.Truncate(time.Microsecond)
+		record.End = record.End.Truncate(time.Microsecond)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.MultiKeySortFixture.BaseSchema, kallaxRecords...)
 
 }
 
+// CopyFrom inserts the MultiKeySortFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *MultiKeySortFixtureStore) CopyFrom(records <-chan *MultiKeySortFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the MultiKeySortFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *MultiKeySortFixtureStore) CopyFromContext(ctx context.Context, records <-chan *MultiKeySortFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.MultiKeySortFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *MultiKeySortFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		record.Start = record.Start.Truncate(time.Microsecond)
+		record.End = record.End.Truncate(time.Microsecond)
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -2494,21 +4436,34 @@ func (s *MultiKeySortFixtureStore) Insert(record *MultiKeySortFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *MultiKeySortFixtureStore) Update(record *MultiKeySortFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *MultiKeySortFixtureStore) UpdateContext(ctx context.Context, record *MultiKeySortFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.Start = record.Start.Truncate(time.Microsecond)
 	record.End = record.End.Truncate(time.Microsecond)
 
-	return s.Store.Update(Schema.MultiKeySortFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *MultiKeySortFixtureStore) Save(record *MultiKeySortFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *MultiKeySortFixtureStore) SaveContext(ctx context.Context, record *MultiKeySortFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -2516,16 +4471,75 @@ func (s *MultiKeySortFixtureStore) Save(record *MultiKeySortFixture) (updated bo
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *MultiKeySortFixtureStore) Upsert(record *MultiKeySortFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *MultiKeySortFixtureStore) UpsertContext(ctx context.Context, record *MultiKeySortFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.Start = record.Start.Truncate(time.Microsecond)
+	record.End = record.End.Truncate(time.Microsecond)
+
+	return s.Store.UpsertContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *MultiKeySortFixtureStore) Delete(record *MultiKeySortFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *MultiKeySortFixtureStore) DeleteContext(ctx context.Context, record *MultiKeySortFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
 
-	return s.Store.Delete(Schema.MultiKeySortFixture.BaseSchema, record)
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *MultiKeySortFixtureStore) UpdateAll(q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *MultiKeySortFixtureStore) UpdateAllContext(ctx context.Context, q *MultiKeySortFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *MultiKeySortFixtureStore) DeleteAll(q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *MultiKeySortFixtureStore) DeleteAllContext(ctx context.Context, q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *MultiKeySortFixtureStore) Find(q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *MultiKeySortFixtureStore) FindContext(ctx context.Context, q *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2539,24 +4553,74 @@ func (s *MultiKeySortFixtureStore) MustFind(q *MultiKeySortFixtureQuery) *MultiK
 	return NewMultiKeySortFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as MultiKeySortFixture records.
+func (s *MultiKeySortFixtureStore) FindInto(q *MultiKeySortFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *MultiKeySortFixtureStore) FindIntoContext(ctx context.Context, q *MultiKeySortFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *MultiKeySortFixtureStore) Count(q *MultiKeySortFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *MultiKeySortFixtureStore) CountContext(ctx context.Context, q *MultiKeySortFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *MultiKeySortFixtureStore) MustCount(q *MultiKeySortFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *MultiKeySortFixtureStore) Aggregate(q *MultiKeySortFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *MultiKeySortFixtureStore) AggregateContext(ctx context.Context, q *MultiKeySortFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *MultiKeySortFixtureStore) AggregateInto(q *MultiKeySortFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *MultiKeySortFixtureStore) AggregateIntoContext(ctx context.Context, q *MultiKeySortFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *MultiKeySortFixtureStore) FindOne(q *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *MultiKeySortFixtureStore) FindOneContext(ctx context.Context, q *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2579,7 +4643,13 @@ func (s *MultiKeySortFixtureStore) FindOne(q *MultiKeySortFixtureQuery) (*MultiK
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *MultiKeySortFixtureStore) FindAll(q *MultiKeySortFixtureQuery) ([]*MultiKeySortFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *MultiKeySortFixtureStore) FindAllContext(ctx context.Context, q *MultiKeySortFixtureQuery) ([]*MultiKeySortFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2603,16 +4673,52 @@ func (s *MultiKeySortFixtureStore) Reload(record *MultiKeySortFixture) error {
 	return s.Store.Reload(Schema.MultiKeySortFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the MultiKeySortFixture with the data in the database using
+// the given context and makes it writable.
+func (s *MultiKeySortFixtureStore) ReloadContext(ctx context.Context, record *MultiKeySortFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.MultiKeySortFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *MultiKeySortFixtureStore) Transaction(callback func(*MultiKeySortFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *MultiKeySortFixtureStore) TransactionContext(ctx context.Context, callback func(*MultiKeySortFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&MultiKeySortFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *MultiKeySortFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*MultiKeySortFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *MultiKeySortFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*MultiKeySortFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&MultiKeySortFixtureStore{store})
 	})
 }
@@ -2666,6 +4772,13 @@ func (q *MultiKeySortFixtureQuery) BatchSize(size uint64) *MultiKeySortFixtureQu
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *MultiKeySortFixtureQuery) UseCursor() *MultiKeySortFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *MultiKeySortFixtureQuery) Limit(n uint64) *MultiKeySortFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -2678,6 +4791,20 @@ func (q *MultiKeySortFixtureQuery) Offset(n uint64) *MultiKeySortFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *MultiKeySortFixtureQuery) After(cursor kallax.PageCursor) *MultiKeySortFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *MultiKeySortFixtureQuery) Before(cursor kallax.PageCursor) *MultiKeySortFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *MultiKeySortFixtureQuery) Where(cond kallax.Condition) *MultiKeySortFixtureQuery {
@@ -2685,6 +4812,55 @@ func (q *MultiKeySortFixtureQuery) Where(cond kallax.Condition) *MultiKeySortFix
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *MultiKeySortFixtureQuery) GroupBy(fields ...kallax.SchemaField) *MultiKeySortFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *MultiKeySortFixtureQuery) Having(cond kallax.Condition) *MultiKeySortFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *MultiKeySortFixtureQuery) ForUpdate() *MultiKeySortFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *MultiKeySortFixtureQuery) ForShare() *MultiKeySortFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *MultiKeySortFixtureQuery) SkipLocked() *MultiKeySortFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *MultiKeySortFixtureQuery) NoWait() *MultiKeySortFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *MultiKeySortFixtureQuery) Of(schemas ...kallax.Schema) *MultiKeySortFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -2820,6 +4996,18 @@ func (rs *MultiKeySortFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last MultiKeySortFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *MultiKeySortFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *MultiKeySortFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -2907,6 +5095,12 @@ func NewNullableStore(db *sql.DB) *NullableStore {
 	return &NullableStore{kallax.NewStore(db)}
 }
 
+// NewNullableStoreWithDialect creates a new instance of NullableStore
+// using a SQL database of the given dialect.
+func NewNullableStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *NullableStore {
+	return &NullableStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *NullableStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -2929,15 +5123,106 @@ func (s *NullableStore) DebugWith(logger kallax.LoggerFunc) *NullableStore {
 	return &NullableStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *NullableStore) WithInterceptors(interceptors ...kallax.Interceptor) *NullableStore {
+	return &NullableStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *NullableStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *NullableStore {
+	return &NullableStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *NullableStore) WithTracer(tracer kallax.Tracer) *NullableStore {
+	return &NullableStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *NullableStore) WithMetrics(sink kallax.MetricsSink) *NullableStore {
+	return &NullableStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *NullableStore) WithRetry(policy kallax.RetryPolicy) *NullableStore {
+	return &NullableStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a Nullable in the database. A non-persisted object is
 // required for this operation.
 func (s *NullableStore) Insert(record *Nullable) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Nullable in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *NullableStore) InsertContext(ctx context.Context, record *Nullable) error {
 	if record.T != nil {
 		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
 	}
 
-	return s.Store.Insert(Schema.Nullable.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.Nullable.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given Nullable records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *NullableStore) InsertMany(records []*Nullable) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given Nullable records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *NullableStore) InsertManyContext(ctx context.Context, records []*Nullable) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+		if record.T != nil {
+			record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+		}
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.Nullable.BaseSchema, kallaxRecords...)
+
+}
+
+// CopyFrom inserts the Nullable records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *NullableStore) CopyFrom(records <-chan *Nullable) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Nullable records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *NullableStore) CopyFromContext(ctx context.Context, records <-chan *Nullable) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Nullable.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Nullable
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if record.T != nil {
+			record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -2947,22 +5232,35 @@ func (s *NullableStore) Insert(record *Nullable) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *NullableStore) Update(record *Nullable, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *NullableStore) UpdateContext(ctx context.Context, record *Nullable, cols ...kallax.SchemaField) (updated int64, err error) {
 	if record.T != nil {
 		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
 	}
 
-	return s.Store.Update(Schema.Nullable.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.Nullable.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *NullableStore) Save(record *Nullable) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *NullableStore) SaveContext(ctx context.Context, record *Nullable) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -2970,16 +5268,76 @@ func (s *NullableStore) Save(record *Nullable) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *NullableStore) Upsert(record *Nullable, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *NullableStore) UpsertContext(ctx context.Context, record *Nullable, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	if record.T != nil {
+		record.T = func(t time.Time) *time.Time { return &t }(record.T.Truncate(time.Microsecond))
+	}
+
+	return s.Store.UpsertContext(ctx, Schema.Nullable.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *NullableStore) Delete(record *Nullable) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *NullableStore) DeleteContext(ctx context.Context, record *Nullable) error {
+
+	return s.Store.DeleteContext(ctx, Schema.Nullable.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *NullableStore) UpdateAll(q *NullableQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *NullableStore) UpdateAllContext(ctx context.Context, q *NullableQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
 
-	return s.Store.Delete(Schema.Nullable.BaseSchema, record)
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *NullableStore) DeleteAll(q *NullableQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *NullableStore) DeleteAllContext(ctx context.Context, q *NullableQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *NullableStore) Find(q *NullableQuery) (*NullableResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *NullableStore) FindContext(ctx context.Context, q *NullableQuery) (*NullableResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -2993,24 +5351,74 @@ func (s *NullableStore) MustFind(q *NullableQuery) *NullableResultSet {
 	return NewNullableResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Nullable records.
+func (s *NullableStore) FindInto(q *NullableQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *NullableStore) FindIntoContext(ctx context.Context, q *NullableQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *NullableStore) Count(q *NullableQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *NullableStore) CountContext(ctx context.Context, q *NullableQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *NullableStore) MustCount(q *NullableQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *NullableStore) Aggregate(q *NullableQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *NullableStore) AggregateContext(ctx context.Context, q *NullableQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *NullableStore) AggregateInto(q *NullableQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *NullableStore) AggregateIntoContext(ctx context.Context, q *NullableQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *NullableStore) FindOne(q *NullableQuery) (*Nullable, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *NullableStore) FindOneContext(ctx context.Context, q *NullableQuery) (*Nullable, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3033,7 +5441,13 @@ func (s *NullableStore) FindOne(q *NullableQuery) (*Nullable, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *NullableStore) FindAll(q *NullableQuery) ([]*Nullable, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *NullableStore) FindAllContext(ctx context.Context, q *NullableQuery) ([]*Nullable, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3057,16 +5471,52 @@ func (s *NullableStore) Reload(record *Nullable) error {
 	return s.Store.Reload(Schema.Nullable.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Nullable with the data in the database using
+// the given context and makes it writable.
+func (s *NullableStore) ReloadContext(ctx context.Context, record *Nullable) error {
+	return s.Store.ReloadContext(ctx, Schema.Nullable.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *NullableStore) Transaction(callback func(*NullableStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *NullableStore) TransactionContext(ctx context.Context, callback func(*NullableStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&NullableStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *NullableStore) TransactionWith(opts *sql.TxOptions, callback func(*NullableStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *NullableStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*NullableStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&NullableStore{store})
 	})
 }
@@ -3120,6 +5570,13 @@ func (q *NullableQuery) BatchSize(size uint64) *NullableQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *NullableQuery) UseCursor() *NullableQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *NullableQuery) Limit(n uint64) *NullableQuery {
 	q.BaseQuery.Limit(n)
@@ -3132,13 +5589,76 @@ func (q *NullableQuery) Offset(n uint64) *NullableQuery {
 	return q
 }
 
-// Where adds a condition to the query. All conditions added are concatenated
-// using a logical AND.
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *NullableQuery) After(cursor kallax.PageCursor) *NullableQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *NullableQuery) Before(cursor kallax.PageCursor) *NullableQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
 func (q *NullableQuery) Where(cond kallax.Condition) *NullableQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *NullableQuery) GroupBy(fields ...kallax.SchemaField) *NullableQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *NullableQuery) Having(cond kallax.Condition) *NullableQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *NullableQuery) ForUpdate() *NullableQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *NullableQuery) ForShare() *NullableQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *NullableQuery) SkipLocked() *NullableQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *NullableQuery) NoWait() *NullableQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *NullableQuery) Of(schemas ...kallax.Schema) *NullableQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -3268,6 +5788,18 @@ func (rs *NullableResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Nullable retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *NullableResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *NullableResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -3367,6 +5899,12 @@ func NewPersonStore(db *sql.DB) *PersonStore {
 	return &PersonStore{kallax.NewStore(db)}
 }
 
+// NewPersonStoreWithDialect creates a new instance of PersonStore
+// using a SQL database of the given dialect.
+func NewPersonStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *PersonStore {
+	return &PersonStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *PersonStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -3389,6 +5927,36 @@ func (s *PersonStore) DebugWith(logger kallax.LoggerFunc) *PersonStore {
 	return &PersonStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *PersonStore) WithInterceptors(interceptors ...kallax.Interceptor) *PersonStore {
+	return &PersonStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *PersonStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *PersonStore {
+	return &PersonStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *PersonStore) WithTracer(tracer kallax.Tracer) *PersonStore {
+	return &PersonStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *PersonStore) WithMetrics(sink kallax.MetricsSink) *PersonStore {
+	return &PersonStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *PersonStore) WithRetry(policy kallax.RetryPolicy) *PersonStore {
+	return &PersonStore{s.Store.WithRetry(policy)}
+}
+
 func (s *PersonStore) relationshipRecords(record *Person) []kallax.RecordWithSchema {
 	var records []kallax.RecordWithSchema
 
@@ -3416,6 +5984,12 @@ func (s *PersonStore) relationshipRecords(record *Person) []kallax.RecordWithSch
 // Insert inserts a Person in the database. A non-persisted object is
 // required for this operation.
 func (s *PersonStore) Insert(record *Person) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Person in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *PersonStore) InsertContext(ctx context.Context, record *Person) error {
 
 	if err := record.BeforeSave(); err != nil {
 		return err
@@ -3424,9 +5998,9 @@ func (s *PersonStore) Insert(record *Person) error {
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
-			if err := s.Insert(Schema.Person.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.Person.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -3436,7 +6010,7 @@ func (s *PersonStore) Insert(record *Person) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -3453,8 +6027,8 @@ func (s *PersonStore) Insert(record *Person) error {
 		})
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Person.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Person.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -3467,6 +6041,96 @@ func (s *PersonStore) Insert(record *Person) error {
 
 }
 
+// InsertMany inserts the given Person records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *PersonStore) InsertMany(records []*Person) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given Person records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *PersonStore) InsertManyContext(ctx context.Context, records []*Person) error {
+
+	var relRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		if err := record.BeforeSave(); err != nil {
+			return err
+		}
+
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.Person.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, r := range relRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the Person records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *PersonStore) CopyFrom(records <-chan *Person) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Person records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *PersonStore) CopyFromContext(ctx context.Context, records <-chan *Person) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Person.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Person
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeSave(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -3474,6 +6138,12 @@ func (s *PersonStore) Insert(record *Person) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *PersonStore) UpdateContext(ctx context.Context, record *Person, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	if err := record.BeforeSave(); err != nil {
 		return 0, err
@@ -3482,9 +6152,9 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 	records := s.relationshipRecords(record)
 
 	if len(records) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
-			updated, err = s.Update(Schema.Person.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -3495,7 +6165,7 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -3517,8 +6187,8 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 		return updated, nil
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Person.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Person.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -3540,11 +6210,18 @@ func (s *PersonStore) Update(record *Person, cols ...kallax.SchemaField) (update
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *PersonStore) Save(record *Person) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *PersonStore) SaveContext(ctx context.Context, record *Person) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -3552,15 +6229,54 @@ func (s *PersonStore) Save(record *Person) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *PersonStore) Upsert(record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *PersonStore) UpsertContext(ctx context.Context, record *Person, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Person.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		if !record.IsPersisted() {
+			return nil
+		}
+
+		return record.AfterSave()
+	})
+
+}
+
 // Delete removes the given record from the database.
 func (s *PersonStore) Delete(record *Person) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *PersonStore) DeleteContext(ctx context.Context, record *Person) error {
 
 	if err := record.BeforeDelete(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Person.BaseSchema, record)
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Person.BaseSchema, record)
 		if err != nil {
 			return err
 		}
@@ -3570,9 +6286,41 @@ func (s *PersonStore) Delete(record *Person) error {
 
 }
 
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *PersonStore) UpdateAll(q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *PersonStore) UpdateAllContext(ctx context.Context, q *PersonQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *PersonStore) DeleteAll(q *PersonQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *PersonStore) DeleteAllContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
 // Find returns the set of results for the given query.
 func (s *PersonStore) Find(q *PersonQuery) (*PersonResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *PersonStore) FindContext(ctx context.Context, q *PersonQuery) (*PersonResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3586,24 +6334,74 @@ func (s *PersonStore) MustFind(q *PersonQuery) *PersonResultSet {
 	return NewPersonResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Person records.
+func (s *PersonStore) FindInto(q *PersonQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *PersonStore) FindIntoContext(ctx context.Context, q *PersonQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *PersonStore) Count(q *PersonQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *PersonStore) CountContext(ctx context.Context, q *PersonQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *PersonStore) MustCount(q *PersonQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *PersonStore) Aggregate(q *PersonQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *PersonStore) AggregateContext(ctx context.Context, q *PersonQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *PersonStore) AggregateInto(q *PersonQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *PersonStore) AggregateIntoContext(ctx context.Context, q *PersonQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *PersonStore) FindOneContext(ctx context.Context, q *PersonQuery) (*Person, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3626,7 +6424,13 @@ func (s *PersonStore) FindOne(q *PersonQuery) (*Person, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *PersonStore) FindAll(q *PersonQuery) ([]*Person, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *PersonStore) FindAllContext(ctx context.Context, q *PersonQuery) ([]*Person, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -3650,16 +6454,52 @@ func (s *PersonStore) Reload(record *Person) error {
 	return s.Store.Reload(Schema.Person.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Person with the data in the database using
+// the given context and makes it writable.
+func (s *PersonStore) ReloadContext(ctx context.Context, record *Person) error {
+	return s.Store.ReloadContext(ctx, Schema.Person.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionContext(ctx context.Context, callback func(*PersonStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&PersonStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionWith(opts *sql.TxOptions, callback func(*PersonStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PersonStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*PersonStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&PersonStore{store})
 	})
 }
@@ -3668,6 +6508,14 @@ func (s *PersonStore) Transaction(callback func(*PersonStore) error) error {
 // model. If no items are given, it removes all of them.
 // The items will also be removed from the passed record inside this method.
 func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
+	return s.RemovePetsContext(context.Background(), record, deleted...)
+}
+
+// RemovePetsContext removes the given items of the Pets field of the
+// model using the given context. If no items are given, it removes all of
+// them.
+// The items will also be removed from the passed record inside this method.
+func (s *PersonStore) RemovePetsContext(ctx context.Context, record *Person, deleted ...*Pet) error {
 	var updated []*Pet
 	var clear bool
 	if len(deleted) == 0 {
@@ -3679,7 +6527,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 	}
 
 	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
+		err := s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 			for _, d := range deleted {
 				var r kallax.Record = d
 
@@ -3689,7 +6537,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 					}
 				}
 
-				if err := s.Delete(Schema.Pet.BaseSchema, d); err != nil {
+				if err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, d); err != nil {
 					return err
 				}
 
@@ -3720,8 +6568,8 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 
 		var err error
 		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.Pet.BaseSchema, r)
+			err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, r)
 				if err != nil {
 					return err
 				}
@@ -3729,7 +6577,7 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 				return afterDeleter.AfterDelete()
 			})
 		} else {
-			err = s.Store.Delete(Schema.Pet.BaseSchema, deleted[0])
+			err = s.Store.DeleteContext(ctx, Schema.Pet.BaseSchema, deleted[0])
 		}
 
 		if err != nil {
@@ -3756,6 +6604,13 @@ func (s *PersonStore) RemovePets(record *Person, deleted ...*Pet) error {
 // RemoveCar removes from the database the given relationship of the
 // model. It also resets the field Car of the model.
 func (s *PersonStore) RemoveCar(record *Person) error {
+	return s.RemoveCarContext(context.Background(), record)
+}
+
+// RemoveCarContext removes from the database the given relationship of
+// the model using the given context. It also resets the field Car of
+// the model.
+func (s *PersonStore) RemoveCarContext(ctx context.Context, record *Person) error {
 	var r kallax.Record = record.Car
 	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 		if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -3765,8 +6620,8 @@ func (s *PersonStore) RemoveCar(record *Person) error {
 
 	var err error
 	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.Car.BaseSchema, r)
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.Car.BaseSchema, r)
 			if err != nil {
 				return err
 			}
@@ -3774,7 +6629,7 @@ func (s *PersonStore) RemoveCar(record *Person) error {
 			return afterDeleter.AfterDelete()
 		})
 	} else {
-		err = s.Store.Delete(Schema.Car.BaseSchema, r)
+		err = s.Store.DeleteContext(ctx, Schema.Car.BaseSchema, r)
 	}
 	if err != nil {
 		return err
@@ -3833,6 +6688,13 @@ func (q *PersonQuery) BatchSize(size uint64) *PersonQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *PersonQuery) UseCursor() *PersonQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *PersonQuery) Limit(n uint64) *PersonQuery {
 	q.BaseQuery.Limit(n)
@@ -3845,6 +6707,20 @@ func (q *PersonQuery) Offset(n uint64) *PersonQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PersonQuery) After(cursor kallax.PageCursor) *PersonQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PersonQuery) Before(cursor kallax.PageCursor) *PersonQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
@@ -3852,16 +6728,85 @@ func (q *PersonQuery) Where(cond kallax.Condition) *PersonQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *PersonQuery) GroupBy(fields ...kallax.SchemaField) *PersonQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *PersonQuery) Having(cond kallax.Condition) *PersonQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *PersonQuery) ForUpdate() *PersonQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *PersonQuery) ForShare() *PersonQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *PersonQuery) SkipLocked() *PersonQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *PersonQuery) NoWait() *PersonQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *PersonQuery) Of(schemas ...kallax.Schema) *PersonQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *PersonQuery) WithPets(cond kallax.Condition) *PersonQuery {
 	q.AddRelation(Schema.Pet.BaseSchema, "Pets", kallax.OneToMany, cond)
 	return q
 }
 
+// WherePetsExist adds a condition to the query that requires that any of
+// the Pets of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *PersonQuery) WherePetsExist(sub *PetQuery) *PersonQuery {
+	if sub == nil {
+		sub = NewPetQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Pets", sub))
+}
+
 func (q *PersonQuery) WithCar() *PersonQuery {
 	q.AddRelation(Schema.Car.BaseSchema, "Car", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereCarExist adds a condition to the query that requires that any of
+// the Car of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *PersonQuery) WhereCarExist(sub *CarQuery) *PersonQuery {
+	if sub == nil {
+		sub = NewCarQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Car", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -3985,6 +6930,18 @@ func (rs *PersonResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Person retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *PersonResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *PersonResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -4075,6 +7032,12 @@ func NewPetStore(db *sql.DB) *PetStore {
 	return &PetStore{kallax.NewStore(db)}
 }
 
+// NewPetStoreWithDialect creates a new instance of PetStore
+// using a SQL database of the given dialect.
+func NewPetStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *PetStore {
+	return &PetStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *PetStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -4097,6 +7060,36 @@ func (s *PetStore) DebugWith(logger kallax.LoggerFunc) *PetStore {
 	return &PetStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *PetStore) WithInterceptors(interceptors ...kallax.Interceptor) *PetStore {
+	return &PetStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *PetStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *PetStore {
+	return &PetStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *PetStore) WithTracer(tracer kallax.Tracer) *PetStore {
+	return &PetStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *PetStore) WithMetrics(sink kallax.MetricsSink) *PetStore {
+	return &PetStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *PetStore) WithRetry(policy kallax.RetryPolicy) *PetStore {
+	return &PetStore{s.Store.WithRetry(policy)}
+}
+
 func (s *PetStore) inverseRecords(record *Pet) []kallax.RecordWithSchema {
 	record.ClearVirtualColumns()
 	var records []kallax.RecordWithSchema
@@ -4115,6 +7108,12 @@ func (s *PetStore) inverseRecords(record *Pet) []kallax.RecordWithSchema {
 // Insert inserts a Pet in the database. A non-persisted object is
 // required for this operation.
 func (s *PetStore) Insert(record *Pet) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a Pet in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *PetStore) InsertContext(ctx context.Context, record *Pet) error {
 
 	if err := record.BeforeSave(); err != nil {
 		return err
@@ -4123,7 +7122,7 @@ func (s *PetStore) Insert(record *Pet) error {
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -4131,7 +7130,7 @@ func (s *PetStore) Insert(record *Pet) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -4140,7 +7139,7 @@ func (s *PetStore) Insert(record *Pet) error {
 				}
 			}
 
-			if err := s.Insert(Schema.Pet.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.Pet.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -4152,8 +7151,8 @@ func (s *PetStore) Insert(record *Pet) error {
 		})
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		if err := s.Insert(Schema.Pet.BaseSchema, record); err != nil {
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.InsertContext(ctx, Schema.Pet.BaseSchema, record); err != nil {
 			return err
 		}
 
@@ -4166,44 +7165,140 @@ func (s *PetStore) Insert(record *Pet) error {
 
 }
 
-// Update updates the given record on the database. If the columns are given,
-// only these columns will be updated. Otherwise all of them will be.
-// Be very careful with this, as you will have a potentially different object
-// in memory but not on the database.
-// Only writable records can be updated. Writable objects are those that have
-// been just inserted or retrieved using a query with no custom select fields.
-func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+// InsertMany inserts the given Pet records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *PetStore) InsertMany(records []*Pet) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-	if err := record.BeforeSave(); err != nil {
-		return 0, err
-	}
+// InsertManyContext inserts the given Pet records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *PetStore) InsertManyContext(ctx context.Context, records []*Pet) error {
 
-	inverseRecords := s.inverseRecords(record)
+	var inverseRecords []kallax.RecordWithSchema
 
-	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
 
-			for _, r := range inverseRecords {
-				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
-					return err
-				}
-				persisted := r.Record.IsPersisted()
+		if err := record.BeforeSave(); err != nil {
+			return err
+		}
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
-					return err
-				}
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
 
-				if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
-					return err
-				}
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		for _, r := range inverseRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
 			}
+			persisted := r.Record.IsPersisted()
 
-			updated, err = s.Update(Schema.Pet.BaseSchema, record, cols...)
-			if err != nil {
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 				return err
 			}
 
-			if err := record.AfterSave(); err != nil {
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InsertManyContext(ctx, Schema.Pet.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			if err := record.AfterSave(); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the Pet records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *PetStore) CopyFrom(records <-chan *Pet) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the Pet records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *PetStore) CopyFromContext(ctx context.Context, records <-chan *Pet) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.Pet.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *Pet
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if err := record.BeforeSave(); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}))
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *PetStore) UpdateContext(ctx context.Context, record *Pet, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	if err := record.BeforeSave(); err != nil {
+		return 0, err
+	}
+
+	inverseRecords := s.inverseRecords(record)
+
+	if len(inverseRecords) > 0 {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+			for _, r := range inverseRecords {
+				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+					return err
+				}
+				persisted := r.Record.IsPersisted()
+
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+					return err
+				}
+
+				if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+					return err
+				}
+			}
+
+			updated, err = s.UpdateContext(ctx, Schema.Pet.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			if err := record.AfterSave(); err != nil {
 				return err
 			}
 
@@ -4216,8 +7311,8 @@ func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int6
 		return updated, nil
 	}
 
-	err = s.Store.Transaction(func(s *kallax.Store) error {
-		updated, err = s.Update(Schema.Pet.BaseSchema, record, cols...)
+	err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		updated, err = s.UpdateContext(ctx, Schema.Pet.BaseSchema, record, cols...)
 		if err != nil {
 			return err
 		}
@@ -4239,11 +7334,18 @@ func (s *PetStore) Update(record *Pet, cols ...kallax.SchemaField) (updated int6
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *PetStore) Save(record *Pet) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *PetStore) SaveContext(ctx context.Context, record *Pet) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -4251,15 +7353,54 @@ func (s *PetStore) Save(record *Pet) (updated bool, err error) {
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *PetStore) Upsert(record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *PetStore) UpsertContext(ctx context.Context, record *Pet, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	if err := record.BeforeSave(); err != nil {
+		return err
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		if err := s.UpsertContext(ctx, Schema.Pet.BaseSchema, record, conflictCols, updateCols...); err != nil {
+			return err
+		}
+
+		if !record.IsPersisted() {
+			return nil
+		}
+
+		return record.AfterSave()
+	})
+
+}
+
 // Delete removes the given record from the database.
 func (s *PetStore) Delete(record *Pet) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *PetStore) DeleteContext(ctx context.Context, record *Pet) error {
 
 	if err := record.BeforeDelete(); err != nil {
 		return err
 	}
 
-	return s.Store.Transaction(func(s *kallax.Store) error {
-		err := s.Delete(Schema.Pet.BaseSchema, record)
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+		err := s.DeleteContext(ctx, Schema.Pet.BaseSchema, record)
 		if err != nil {
 			return err
 		}
@@ -4269,9 +7410,41 @@ func (s *PetStore) Delete(record *Pet) error {
 
 }
 
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *PetStore) UpdateAll(q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *PetStore) UpdateAllContext(ctx context.Context, q *PetQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *PetStore) DeleteAll(q *PetQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *PetStore) DeleteAllContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
 // Find returns the set of results for the given query.
 func (s *PetStore) Find(q *PetQuery) (*PetResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *PetStore) FindContext(ctx context.Context, q *PetQuery) (*PetResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4285,24 +7458,74 @@ func (s *PetStore) MustFind(q *PetQuery) *PetResultSet {
 	return NewPetResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as Pet records.
+func (s *PetStore) FindInto(q *PetQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *PetStore) FindIntoContext(ctx context.Context, q *PetQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *PetStore) Count(q *PetQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *PetStore) CountContext(ctx context.Context, q *PetQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *PetStore) MustCount(q *PetQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *PetStore) Aggregate(q *PetQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *PetStore) AggregateContext(ctx context.Context, q *PetQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *PetStore) AggregateInto(q *PetQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *PetStore) AggregateIntoContext(ctx context.Context, q *PetQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *PetStore) FindOneContext(ctx context.Context, q *PetQuery) (*Pet, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4325,7 +7548,13 @@ func (s *PetStore) FindOne(q *PetQuery) (*Pet, error) {
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *PetStore) FindAll(q *PetQuery) ([]*Pet, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *PetStore) FindAllContext(ctx context.Context, q *PetQuery) ([]*Pet, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -4349,16 +7578,52 @@ func (s *PetStore) Reload(record *Pet) error {
 	return s.Store.Reload(Schema.Pet.BaseSchema, record)
 }
 
+// ReloadContext refreshes the Pet with the data in the database using
+// the given context and makes it writable.
+func (s *PetStore) ReloadContext(ctx context.Context, record *Pet) error {
+	return s.Store.ReloadContext(ctx, Schema.Pet.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *PetStore) Transaction(callback func(*PetStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionContext(ctx context.Context, callback func(*PetStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&PetStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionWith(opts *sql.TxOptions, callback func(*PetStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *PetStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*PetStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&PetStore{store})
 	})
 }
@@ -4412,6 +7677,13 @@ func (q *PetQuery) BatchSize(size uint64) *PetQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *PetQuery) UseCursor() *PetQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *PetQuery) Limit(n uint64) *PetQuery {
 	q.BaseQuery.Limit(n)
@@ -4424,6 +7696,20 @@ func (q *PetQuery) Offset(n uint64) *PetQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PetQuery) After(cursor kallax.PageCursor) *PetQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *PetQuery) Before(cursor kallax.PageCursor) *PetQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
@@ -4431,11 +7717,70 @@ func (q *PetQuery) Where(cond kallax.Condition) *PetQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *PetQuery) GroupBy(fields ...kallax.SchemaField) *PetQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *PetQuery) Having(cond kallax.Condition) *PetQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *PetQuery) ForUpdate() *PetQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *PetQuery) ForShare() *PetQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *PetQuery) SkipLocked() *PetQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *PetQuery) NoWait() *PetQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *PetQuery) Of(schemas ...kallax.Schema) *PetQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *PetQuery) WithOwner() *PetQuery {
 	q.AddRelation(Schema.Person.BaseSchema, "Owner", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereOwnerExist adds a condition to the query that requires that any of
+// the Owner of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *PetQuery) WhereOwnerExist(sub *PersonQuery) *PetQuery {
+	if sub == nil {
+		sub = NewPersonQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Owner", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -4571,6 +7916,18 @@ func (rs *PetResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last Pet retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *PetResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *PetResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -4794,6 +8151,12 @@ func NewQueryFixtureStore(db *sql.DB) *QueryFixtureStore {
 	return &QueryFixtureStore{kallax.NewStore(db)}
 }
 
+// NewQueryFixtureStoreWithDialect creates a new instance of QueryFixtureStore
+// using a SQL database of the given dialect.
+func NewQueryFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *QueryFixtureStore {
+	return &QueryFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *QueryFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -4816,6 +8179,36 @@ func (s *QueryFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryFixtureSto
 	return &QueryFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *QueryFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *QueryFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *QueryFixtureStore) WithTracer(tracer kallax.Tracer) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *QueryFixtureStore) WithMetrics(sink kallax.MetricsSink) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *QueryFixtureStore) WithRetry(policy kallax.RetryPolicy) *QueryFixtureStore {
+	return &QueryFixtureStore{s.Store.WithRetry(policy)}
+}
+
 func (s *QueryFixtureStore) relationshipRecords(record *QueryFixture) []kallax.RecordWithSchema {
 	var records []kallax.RecordWithSchema
 
@@ -4858,6 +8251,12 @@ func (s *QueryFixtureStore) inverseRecords(record *QueryFixture) []kallax.Record
 // Insert inserts a QueryFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a QueryFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *QueryFixtureStore) InsertContext(ctx context.Context, record *QueryFixture) error {
 	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
 
 	records := s.relationshipRecords(record)
@@ -4865,7 +8264,7 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 && len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -4873,7 +8272,7 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -4882,7 +8281,7 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 				}
 			}
 
-			if err := s.Insert(Schema.QueryFixture.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.QueryFixture.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -4892,7 +8291,7 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -4905,8 +8304,104 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.QueryFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.QueryFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given QueryFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *QueryFixtureStore) InsertMany(records []*QueryFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given QueryFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *QueryFixtureStore) InsertManyContext(ctx context.Context, records []*QueryFixture) error {
+
+	var inverseRecords []kallax.RecordWithSchema
+
+	var relRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+		record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		for _, r := range inverseRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InsertManyContext(ctx, Schema.QueryFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, r := range relRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the QueryFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *QueryFixtureStore) CopyFrom(records <-chan *QueryFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the QueryFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *QueryFixtureStore) CopyFromContext(ctx context.Context, records <-chan *QueryFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.QueryFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *QueryFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -4916,6 +8411,12 @@ func (s *QueryFixtureStore) Insert(record *QueryFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *QueryFixtureStore) UpdateContext(ctx context.Context, record *QueryFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
 
 	records := s.relationshipRecords(record)
@@ -4923,7 +8424,7 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 && len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -4931,7 +8432,7 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -4940,7 +8441,7 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 				}
 			}
 
-			updated, err = s.Update(Schema.QueryFixture.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.QueryFixture.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -4951,7 +8452,7 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -4969,18 +8470,25 @@ func (s *QueryFixtureStore) Update(record *QueryFixture, cols ...kallax.SchemaFi
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.QueryFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.QueryFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *QueryFixtureStore) Save(record *QueryFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *QueryFixtureStore) SaveContext(ctx context.Context, record *QueryFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -4988,47 +8496,283 @@ func (s *QueryFixtureStore) Save(record *QueryFixture) (updated bool, err error)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *QueryFixtureStore) Upsert(record *QueryFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *QueryFixtureStore) UpsertContext(ctx context.Context, record *QueryFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	record.TimeParam = record.TimeParam.Truncate(time.Microsecond)
+
+	return s.Store.UpsertContext(ctx, Schema.QueryFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *QueryFixtureStore) Delete(record *QueryFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
 
-	return s.Store.Delete(Schema.QueryFixture.BaseSchema, record)
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *QueryFixtureStore) DeleteContext(ctx context.Context, record *QueryFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.QueryFixture.BaseSchema, record)
 
 }
 
-// Find returns the set of results for the given query.
-func (s *QueryFixtureStore) Find(q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
-	if err != nil {
-		return nil, err
-	}
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *QueryFixtureStore) UpdateAll(q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
 
-	return NewQueryFixtureResultSet(rs), nil
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *QueryFixtureStore) UpdateAllContext(ctx context.Context, q *QueryFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
 }
 
-// MustFind returns the set of results for the given query, but panics if there
-// is any error.
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *QueryFixtureStore) DeleteAll(q *QueryFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *QueryFixtureStore) DeleteAllContext(ctx context.Context, q *QueryFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
+// Find returns the set of results for the given query.
+func (s *QueryFixtureStore) Find(q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *QueryFixtureStore) FindContext(ctx context.Context, q *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
 func (s *QueryFixtureStore) MustFind(q *QueryFixtureQuery) *QueryFixtureResultSet {
 	return NewQueryFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as QueryFixture records.
+func (s *QueryFixtureStore) FindInto(q *QueryFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *QueryFixtureStore) FindIntoContext(ctx context.Context, q *QueryFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *QueryFixtureStore) Count(q *QueryFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *QueryFixtureStore) CountContext(ctx context.Context, q *QueryFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *QueryFixtureStore) MustCount(q *QueryFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *QueryFixtureStore) Aggregate(q *QueryFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *QueryFixtureStore) AggregateContext(ctx context.Context, q *QueryFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *QueryFixtureStore) AggregateInto(q *QueryFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *QueryFixtureStore) AggregateIntoContext(ctx context.Context, q *QueryFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// SumInteger returns the sum of the Integer property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) SumInteger(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.QueryFixture.Integer), &v)
+	return v, err
+}
+
+// AvgInteger returns the average of the Integer property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) AvgInteger(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.QueryFixture.Integer), &v)
+	return v, err
+}
+
+// MinInteger returns the minimum value of the Integer property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MinInteger(q *QueryFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.QueryFixture.Integer), &v)
+	return v, err
+}
+
+// MaxInteger returns the maximum value of the Integer property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MaxInteger(q *QueryFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.QueryFixture.Integer), &v)
+	return v, err
+}
+
+// SumInteger64 returns the sum of the Integer64 property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) SumInteger64(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.QueryFixture.Integer64), &v)
+	return v, err
+}
+
+// AvgInteger64 returns the average of the Integer64 property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) AvgInteger64(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.QueryFixture.Integer64), &v)
+	return v, err
+}
+
+// MinInteger64 returns the minimum value of the Integer64 property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MinInteger64(q *QueryFixtureQuery) (int64, error) {
+	var v int64
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.QueryFixture.Integer64), &v)
+	return v, err
+}
+
+// MaxInteger64 returns the maximum value of the Integer64 property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MaxInteger64(q *QueryFixtureQuery) (int64, error) {
+	var v int64
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.QueryFixture.Integer64), &v)
+	return v, err
+}
+
+// SumFloat32 returns the sum of the Float32 property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) SumFloat32(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.QueryFixture.Float32), &v)
+	return v, err
+}
+
+// AvgFloat32 returns the average of the Float32 property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) AvgFloat32(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.QueryFixture.Float32), &v)
+	return v, err
+}
+
+// MinFloat32 returns the minimum value of the Float32 property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MinFloat32(q *QueryFixtureQuery) (float32, error) {
+	var v float32
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.QueryFixture.Float32), &v)
+	return v, err
+}
+
+// MaxFloat32 returns the maximum value of the Float32 property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MaxFloat32(q *QueryFixtureQuery) (float32, error) {
+	var v float32
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.QueryFixture.Float32), &v)
+	return v, err
+}
+
+// SumAliasIntParam returns the sum of the AliasIntParam property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) SumAliasIntParam(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.QueryFixture.AliasIntParam), &v)
+	return v, err
+}
+
+// AvgAliasIntParam returns the average of the AliasIntParam property of the items
+// selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) AvgAliasIntParam(q *QueryFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.QueryFixture.AliasIntParam), &v)
+	return v, err
+}
+
+// MinAliasIntParam returns the minimum value of the AliasIntParam property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MinAliasIntParam(q *QueryFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.QueryFixture.AliasIntParam), &v)
+	return v, err
+}
+
+// MaxAliasIntParam returns the maximum value of the AliasIntParam property of the
+// items selected by the given query, or zero if there are none.
+func (s *QueryFixtureStore) MaxAliasIntParam(q *QueryFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.QueryFixture.AliasIntParam), &v)
+	return v, err
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *QueryFixtureStore) FindOne(q *QueryFixtureQuery) (*QueryFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *QueryFixtureStore) FindOneContext(ctx context.Context, q *QueryFixtureQuery) (*QueryFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5051,7 +8795,13 @@ func (s *QueryFixtureStore) FindOne(q *QueryFixtureQuery) (*QueryFixture, error)
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *QueryFixtureStore) FindAll(q *QueryFixtureQuery) ([]*QueryFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *QueryFixtureStore) FindAllContext(ctx context.Context, q *QueryFixtureQuery) ([]*QueryFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5075,16 +8825,52 @@ func (s *QueryFixtureStore) Reload(record *QueryFixture) error {
 	return s.Store.Reload(Schema.QueryFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the QueryFixture with the data in the database using
+// the given context and makes it writable.
+func (s *QueryFixtureStore) ReloadContext(ctx context.Context, record *QueryFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.QueryFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *QueryFixtureStore) Transaction(callback func(*QueryFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryFixtureStore) TransactionContext(ctx context.Context, callback func(*QueryFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&QueryFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*QueryFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*QueryFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&QueryFixtureStore{store})
 	})
 }
@@ -5092,6 +8878,13 @@ func (s *QueryFixtureStore) Transaction(callback func(*QueryFixtureStore) error)
 // RemoveRelation removes from the database the given relationship of the
 // model. It also resets the field Relation of the model.
 func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
+	return s.RemoveRelationContext(context.Background(), record)
+}
+
+// RemoveRelationContext removes from the database the given relationship of
+// the model using the given context. It also resets the field Relation of
+// the model.
+func (s *QueryFixtureStore) RemoveRelationContext(ctx context.Context, record *QueryFixture) error {
 	var r kallax.Record = record.Relation
 	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 		if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -5101,8 +8894,8 @@ func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
 
 	var err error
 	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.QueryRelationFixture.BaseSchema, r)
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
 			if err != nil {
 				return err
 			}
@@ -5110,7 +8903,7 @@ func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
 			return afterDeleter.AfterDelete()
 		})
 	} else {
-		err = s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, r)
+		err = s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
 	}
 	if err != nil {
 		return err
@@ -5124,6 +8917,14 @@ func (s *QueryFixtureStore) RemoveRelation(record *QueryFixture) error {
 // model. If no items are given, it removes all of them.
 // The items will also be removed from the passed record inside this method.
 func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*QueryRelationFixture) error {
+	return s.RemoveNRelationContext(context.Background(), record, deleted...)
+}
+
+// RemoveNRelationContext removes the given items of the NRelation field of the
+// model using the given context. If no items are given, it removes all of
+// them.
+// The items will also be removed from the passed record inside this method.
+func (s *QueryFixtureStore) RemoveNRelationContext(ctx context.Context, record *QueryFixture, deleted ...*QueryRelationFixture) error {
 	var updated []*QueryRelationFixture
 	var clear bool
 	if len(deleted) == 0 {
@@ -5135,7 +8936,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 	}
 
 	if len(deleted) > 1 {
-		err := s.Store.Transaction(func(s *kallax.Store) error {
+		err := s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 			for _, d := range deleted {
 				var r kallax.Record = d
 
@@ -5145,7 +8946,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 					}
 				}
 
-				if err := s.Delete(Schema.QueryRelationFixture.BaseSchema, d); err != nil {
+				if err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, d); err != nil {
 					return err
 				}
 
@@ -5176,8 +8977,8 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 
 		var err error
 		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-			err = s.Store.Transaction(func(s *kallax.Store) error {
-				err := s.Delete(Schema.QueryRelationFixture.BaseSchema, r)
+			err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, r)
 				if err != nil {
 					return err
 				}
@@ -5185,7 +8986,7 @@ func (s *QueryFixtureStore) RemoveNRelation(record *QueryFixture, deleted ...*Qu
 				return afterDeleter.AfterDelete()
 			})
 		} else {
-			err = s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, deleted[0])
+			err = s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, deleted[0])
 		}
 
 		if err != nil {
@@ -5258,6 +9059,13 @@ func (q *QueryFixtureQuery) BatchSize(size uint64) *QueryFixtureQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *QueryFixtureQuery) UseCursor() *QueryFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *QueryFixtureQuery) Limit(n uint64) *QueryFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -5270,6 +9078,20 @@ func (q *QueryFixtureQuery) Offset(n uint64) *QueryFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *QueryFixtureQuery) After(cursor kallax.PageCursor) *QueryFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *QueryFixtureQuery) Before(cursor kallax.PageCursor) *QueryFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *QueryFixtureQuery) Where(cond kallax.Condition) *QueryFixtureQuery {
@@ -5277,21 +9099,100 @@ func (q *QueryFixtureQuery) Where(cond kallax.Condition) *QueryFixtureQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *QueryFixtureQuery) GroupBy(fields ...kallax.SchemaField) *QueryFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *QueryFixtureQuery) Having(cond kallax.Condition) *QueryFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *QueryFixtureQuery) ForUpdate() *QueryFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *QueryFixtureQuery) ForShare() *QueryFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *QueryFixtureQuery) SkipLocked() *QueryFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *QueryFixtureQuery) NoWait() *QueryFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *QueryFixtureQuery) Of(schemas ...kallax.Schema) *QueryFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *QueryFixtureQuery) WithRelation() *QueryFixtureQuery {
 	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Relation", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereRelationExist adds a condition to the query that requires that any of
+// the Relation of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *QueryFixtureQuery) WhereRelationExist(sub *QueryRelationFixtureQuery) *QueryFixtureQuery {
+	if sub == nil {
+		sub = NewQueryRelationFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Relation", sub))
+}
+
 func (q *QueryFixtureQuery) WithInverse() *QueryFixtureQuery {
 	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "Inverse", kallax.OneToOne, nil)
 	return q
 }
 
+// WhereInverseExist adds a condition to the query that requires that any of
+// the Inverse of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *QueryFixtureQuery) WhereInverseExist(sub *QueryRelationFixtureQuery) *QueryFixtureQuery {
+	if sub == nil {
+		sub = NewQueryRelationFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Inverse", sub))
+}
+
 func (q *QueryFixtureQuery) WithNRelation(cond kallax.Condition) *QueryFixtureQuery {
 	q.AddRelation(Schema.QueryRelationFixture.BaseSchema, "NRelation", kallax.OneToMany, cond)
 	return q
 }
 
+// WhereNRelationExist adds a condition to the query that requires that any of
+// the NRelation of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *QueryFixtureQuery) WhereNRelationExist(sub *QueryRelationFixtureQuery) *QueryFixtureQuery {
+	if sub == nil {
+		sub = NewQueryRelationFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("NRelation", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -5597,6 +9498,18 @@ func (rs *QueryFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last QueryFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *QueryFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *QueryFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -5683,6 +9596,12 @@ func NewQueryRelationFixtureStore(db *sql.DB) *QueryRelationFixtureStore {
 	return &QueryRelationFixtureStore{kallax.NewStore(db)}
 }
 
+// NewQueryRelationFixtureStoreWithDialect creates a new instance of QueryRelationFixtureStore
+// using a SQL database of the given dialect.
+func NewQueryRelationFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *QueryRelationFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -5705,6 +9624,36 @@ func (s *QueryRelationFixtureStore) DebugWith(logger kallax.LoggerFunc) *QueryRe
 	return &QueryRelationFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *QueryRelationFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *QueryRelationFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *QueryRelationFixtureStore) WithTracer(tracer kallax.Tracer) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *QueryRelationFixtureStore) WithMetrics(sink kallax.MetricsSink) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *QueryRelationFixtureStore) WithRetry(policy kallax.RetryPolicy) *QueryRelationFixtureStore {
+	return &QueryRelationFixtureStore{s.Store.WithRetry(policy)}
+}
+
 func (s *QueryRelationFixtureStore) inverseRecords(record *QueryRelationFixture) []kallax.RecordWithSchema {
 	record.ClearVirtualColumns()
 	var records []kallax.RecordWithSchema
@@ -5723,11 +9672,17 @@ func (s *QueryRelationFixtureStore) inverseRecords(record *QueryRelationFixture)
 // Insert inserts a QueryRelationFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a QueryRelationFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *QueryRelationFixtureStore) InsertContext(ctx context.Context, record *QueryRelationFixture) error {
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -5735,7 +9690,7 @@ func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -5744,7 +9699,7 @@ func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
 				}
 			}
 
-			if err := s.Insert(Schema.QueryRelationFixture.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -5752,10 +9707,84 @@ func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.QueryRelationFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given QueryRelationFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *QueryRelationFixtureStore) InsertMany(records []*QueryRelationFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given QueryRelationFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *QueryRelationFixtureStore) InsertManyContext(ctx context.Context, records []*QueryRelationFixture) error {
+
+	var inverseRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		for _, r := range inverseRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InsertManyContext(ctx, Schema.QueryRelationFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		return nil
+	})
 
 }
 
+// CopyFrom inserts the QueryRelationFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *QueryRelationFixtureStore) CopyFrom(records <-chan *QueryRelationFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the QueryRelationFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *QueryRelationFixtureStore) CopyFromContext(ctx context.Context, records <-chan *QueryRelationFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.QueryRelationFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *QueryRelationFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		return record, nil
+	}))
+}
+
 // Update updates the given record on the database. If the columns are given,
 // only these columns will be updated. Otherwise all of them will be.
 // Be very careful with this, as you will have a potentially different object
@@ -5763,11 +9792,17 @@ func (s *QueryRelationFixtureStore) Insert(record *QueryRelationFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *QueryRelationFixtureStore) UpdateContext(ctx context.Context, record *QueryRelationFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -5775,7 +9810,7 @@ func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ..
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -5784,7 +9819,7 @@ func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ..
 				}
 			}
 
-			updated, err = s.Update(Schema.QueryRelationFixture.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -5798,18 +9833,25 @@ func (s *QueryRelationFixtureStore) Update(record *QueryRelationFixture, cols ..
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.QueryRelationFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *QueryRelationFixtureStore) Save(record *QueryRelationFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *QueryRelationFixtureStore) SaveContext(ctx context.Context, record *QueryRelationFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -5817,16 +9859,73 @@ func (s *QueryRelationFixtureStore) Save(record *QueryRelationFixture) (updated
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *QueryRelationFixtureStore) Upsert(record *QueryRelationFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *QueryRelationFixtureStore) UpsertContext(ctx context.Context, record *QueryRelationFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.QueryRelationFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *QueryRelationFixtureStore) Delete(record *QueryRelationFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *QueryRelationFixtureStore) DeleteContext(ctx context.Context, record *QueryRelationFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *QueryRelationFixtureStore) UpdateAll(q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *QueryRelationFixtureStore) UpdateAllContext(ctx context.Context, q *QueryRelationFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
 
-	return s.Store.Delete(Schema.QueryRelationFixture.BaseSchema, record)
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *QueryRelationFixtureStore) DeleteAll(q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *QueryRelationFixtureStore) DeleteAllContext(ctx context.Context, q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *QueryRelationFixtureStore) Find(q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *QueryRelationFixtureStore) FindContext(ctx context.Context, q *QueryRelationFixtureQuery) (*QueryRelationFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5840,24 +9939,74 @@ func (s *QueryRelationFixtureStore) MustFind(q *QueryRelationFixtureQuery) *Quer
 	return NewQueryRelationFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as QueryRelationFixture records.
+func (s *QueryRelationFixtureStore) FindInto(q *QueryRelationFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *QueryRelationFixtureStore) FindIntoContext(ctx context.Context, q *QueryRelationFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *QueryRelationFixtureStore) Count(q *QueryRelationFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *QueryRelationFixtureStore) CountContext(ctx context.Context, q *QueryRelationFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *QueryRelationFixtureStore) MustCount(q *QueryRelationFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *QueryRelationFixtureStore) Aggregate(q *QueryRelationFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *QueryRelationFixtureStore) AggregateContext(ctx context.Context, q *QueryRelationFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *QueryRelationFixtureStore) AggregateInto(q *QueryRelationFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *QueryRelationFixtureStore) AggregateIntoContext(ctx context.Context, q *QueryRelationFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *QueryRelationFixtureStore) FindOne(q *QueryRelationFixtureQuery) (*QueryRelationFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *QueryRelationFixtureStore) FindOneContext(ctx context.Context, q *QueryRelationFixtureQuery) (*QueryRelationFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5880,7 +10029,13 @@ func (s *QueryRelationFixtureStore) FindOne(q *QueryRelationFixtureQuery) (*Quer
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *QueryRelationFixtureStore) FindAll(q *QueryRelationFixtureQuery) ([]*QueryRelationFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *QueryRelationFixtureStore) FindAllContext(ctx context.Context, q *QueryRelationFixtureQuery) ([]*QueryRelationFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -5904,16 +10059,52 @@ func (s *QueryRelationFixtureStore) Reload(record *QueryRelationFixture) error {
 	return s.Store.Reload(Schema.QueryRelationFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the QueryRelationFixture with the data in the database using
+// the given context and makes it writable.
+func (s *QueryRelationFixtureStore) ReloadContext(ctx context.Context, record *QueryRelationFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.QueryRelationFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *QueryRelationFixtureStore) Transaction(callback func(*QueryRelationFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryRelationFixtureStore) TransactionContext(ctx context.Context, callback func(*QueryRelationFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&QueryRelationFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryRelationFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*QueryRelationFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *QueryRelationFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*QueryRelationFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&QueryRelationFixtureStore{store})
 	})
 }
@@ -5967,6 +10158,13 @@ func (q *QueryRelationFixtureQuery) BatchSize(size uint64) *QueryRelationFixture
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *QueryRelationFixtureQuery) UseCursor() *QueryRelationFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *QueryRelationFixtureQuery) Limit(n uint64) *QueryRelationFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -5979,6 +10177,20 @@ func (q *QueryRelationFixtureQuery) Offset(n uint64) *QueryRelationFixtureQuery
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *QueryRelationFixtureQuery) After(cursor kallax.PageCursor) *QueryRelationFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *QueryRelationFixtureQuery) Before(cursor kallax.PageCursor) *QueryRelationFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *QueryRelationFixtureQuery) Where(cond kallax.Condition) *QueryRelationFixtureQuery {
@@ -5986,11 +10198,70 @@ func (q *QueryRelationFixtureQuery) Where(cond kallax.Condition) *QueryRelationF
 	return q
 }
 
-func (q *QueryRelationFixtureQuery) WithOwner() *QueryRelationFixtureQuery {
-	q.AddRelation(Schema.QueryFixture.BaseSchema, "Owner", kallax.OneToOne, nil)
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *QueryRelationFixtureQuery) GroupBy(fields ...kallax.SchemaField) *QueryRelationFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
 	return q
 }
 
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *QueryRelationFixtureQuery) Having(cond kallax.Condition) *QueryRelationFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *QueryRelationFixtureQuery) ForUpdate() *QueryRelationFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *QueryRelationFixtureQuery) ForShare() *QueryRelationFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *QueryRelationFixtureQuery) SkipLocked() *QueryRelationFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *QueryRelationFixtureQuery) NoWait() *QueryRelationFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *QueryRelationFixtureQuery) Of(schemas ...kallax.Schema) *QueryRelationFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+func (q *QueryRelationFixtureQuery) WithOwner() *QueryRelationFixtureQuery {
+	q.AddRelation(Schema.QueryFixture.BaseSchema, "Owner", kallax.OneToOne, nil)
+	return q
+}
+
+// WhereOwnerExist adds a condition to the query that requires that any of
+// the Owner of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *QueryRelationFixtureQuery) WhereOwnerExist(sub *QueryFixtureQuery) *QueryRelationFixtureQuery {
+	if sub == nil {
+		sub = NewQueryFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Owner", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -6120,6 +10391,18 @@ func (rs *QueryRelationFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last QueryRelationFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *QueryRelationFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *QueryRelationFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -6184,6 +10467,12 @@ func NewResultSetFixtureStore(db *sql.DB) *ResultSetFixtureStore {
 	return &ResultSetFixtureStore{kallax.NewStore(db)}
 }
 
+// NewResultSetFixtureStoreWithDialect creates a new instance of ResultSetFixtureStore
+// using a SQL database of the given dialect.
+func NewResultSetFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *ResultSetFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -6206,12 +10495,96 @@ func (s *ResultSetFixtureStore) DebugWith(logger kallax.LoggerFunc) *ResultSetFi
 	return &ResultSetFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *ResultSetFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *ResultSetFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *ResultSetFixtureStore) WithTracer(tracer kallax.Tracer) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *ResultSetFixtureStore) WithMetrics(sink kallax.MetricsSink) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *ResultSetFixtureStore) WithRetry(policy kallax.RetryPolicy) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a ResultSetFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *ResultSetFixtureStore) Insert(record *ResultSetFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a ResultSetFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *ResultSetFixtureStore) InsertContext(ctx context.Context, record *ResultSetFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given ResultSetFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *ResultSetFixtureStore) InsertMany(records []*ResultSetFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
 
-	return s.Store.Insert(Schema.ResultSetFixture.BaseSchema, record)
+// InsertManyContext inserts the given ResultSetFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *ResultSetFixtureStore) InsertManyContext(ctx context.Context, records []*ResultSetFixture) error {
 
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.ResultSetFixture.BaseSchema, kallaxRecords...)
+
+}
+
+// CopyFrom inserts the ResultSetFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *ResultSetFixtureStore) CopyFrom(records <-chan *ResultSetFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the ResultSetFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *ResultSetFixtureStore) CopyFromContext(ctx context.Context, records <-chan *ResultSetFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.ResultSetFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *ResultSetFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -6221,19 +10594,32 @@ func (s *ResultSetFixtureStore) Insert(record *ResultSetFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *ResultSetFixtureStore) Update(record *ResultSetFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *ResultSetFixtureStore) UpdateContext(ctx context.Context, record *ResultSetFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
-	return s.Store.Update(Schema.ResultSetFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.ResultSetFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *ResultSetFixtureStore) Save(record *ResultSetFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *ResultSetFixtureStore) SaveContext(ctx context.Context, record *ResultSetFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -6241,16 +10627,73 @@ func (s *ResultSetFixtureStore) Save(record *ResultSetFixture) (updated bool, er
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *ResultSetFixtureStore) Upsert(record *ResultSetFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *ResultSetFixtureStore) UpsertContext(ctx context.Context, record *ResultSetFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.ResultSetFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *ResultSetFixtureStore) Delete(record *ResultSetFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *ResultSetFixtureStore) DeleteContext(ctx context.Context, record *ResultSetFixture) error {
 
-	return s.Store.Delete(Schema.ResultSetFixture.BaseSchema, record)
+	return s.Store.DeleteContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *ResultSetFixtureStore) UpdateAll(q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *ResultSetFixtureStore) UpdateAllContext(ctx context.Context, q *ResultSetFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *ResultSetFixtureStore) DeleteAll(q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *ResultSetFixtureStore) DeleteAllContext(ctx context.Context, q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *ResultSetFixtureStore) Find(q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *ResultSetFixtureStore) FindContext(ctx context.Context, q *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6264,24 +10707,74 @@ func (s *ResultSetFixtureStore) MustFind(q *ResultSetFixtureQuery) *ResultSetFix
 	return NewResultSetFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as ResultSetFixture records.
+func (s *ResultSetFixtureStore) FindInto(q *ResultSetFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *ResultSetFixtureStore) FindIntoContext(ctx context.Context, q *ResultSetFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *ResultSetFixtureStore) Count(q *ResultSetFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *ResultSetFixtureStore) CountContext(ctx context.Context, q *ResultSetFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *ResultSetFixtureStore) MustCount(q *ResultSetFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *ResultSetFixtureStore) Aggregate(q *ResultSetFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *ResultSetFixtureStore) AggregateContext(ctx context.Context, q *ResultSetFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *ResultSetFixtureStore) AggregateInto(q *ResultSetFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *ResultSetFixtureStore) AggregateIntoContext(ctx context.Context, q *ResultSetFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *ResultSetFixtureStore) FindOne(q *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *ResultSetFixtureStore) FindOneContext(ctx context.Context, q *ResultSetFixtureQuery) (*ResultSetFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6304,7 +10797,13 @@ func (s *ResultSetFixtureStore) FindOne(q *ResultSetFixtureQuery) (*ResultSetFix
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *ResultSetFixtureStore) FindAll(q *ResultSetFixtureQuery) ([]*ResultSetFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *ResultSetFixtureStore) FindAllContext(ctx context.Context, q *ResultSetFixtureQuery) ([]*ResultSetFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6328,16 +10827,52 @@ func (s *ResultSetFixtureStore) Reload(record *ResultSetFixture) error {
 	return s.Store.Reload(Schema.ResultSetFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the ResultSetFixture with the data in the database using
+// the given context and makes it writable.
+func (s *ResultSetFixtureStore) ReloadContext(ctx context.Context, record *ResultSetFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.ResultSetFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *ResultSetFixtureStore) Transaction(callback func(*ResultSetFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *ResultSetFixtureStore) TransactionContext(ctx context.Context, callback func(*ResultSetFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&ResultSetFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *ResultSetFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*ResultSetFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *ResultSetFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*ResultSetFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&ResultSetFixtureStore{store})
 	})
 }
@@ -6391,6 +10926,13 @@ func (q *ResultSetFixtureQuery) BatchSize(size uint64) *ResultSetFixtureQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *ResultSetFixtureQuery) UseCursor() *ResultSetFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *ResultSetFixtureQuery) Limit(n uint64) *ResultSetFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -6403,6 +10945,20 @@ func (q *ResultSetFixtureQuery) Offset(n uint64) *ResultSetFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *ResultSetFixtureQuery) After(cursor kallax.PageCursor) *ResultSetFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *ResultSetFixtureQuery) Before(cursor kallax.PageCursor) *ResultSetFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *ResultSetFixtureQuery) Where(cond kallax.Condition) *ResultSetFixtureQuery {
@@ -6410,6 +10966,55 @@ func (q *ResultSetFixtureQuery) Where(cond kallax.Condition) *ResultSetFixtureQu
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *ResultSetFixtureQuery) GroupBy(fields ...kallax.SchemaField) *ResultSetFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *ResultSetFixtureQuery) Having(cond kallax.Condition) *ResultSetFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *ResultSetFixtureQuery) ForUpdate() *ResultSetFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *ResultSetFixtureQuery) ForShare() *ResultSetFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *ResultSetFixtureQuery) SkipLocked() *ResultSetFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *ResultSetFixtureQuery) NoWait() *ResultSetFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *ResultSetFixtureQuery) Of(schemas ...kallax.Schema) *ResultSetFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -6533,6 +11138,18 @@ func (rs *ResultSetFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last ResultSetFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *ResultSetFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *ResultSetFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -6651,6 +11268,12 @@ func NewSchemaFixtureStore(db *sql.DB) *SchemaFixtureStore {
 	return &SchemaFixtureStore{kallax.NewStore(db)}
 }
 
+// NewSchemaFixtureStoreWithDialect creates a new instance of SchemaFixtureStore
+// using a SQL database of the given dialect.
+func NewSchemaFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *SchemaFixtureStore {
+	return &SchemaFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *SchemaFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -6673,6 +11296,36 @@ func (s *SchemaFixtureStore) DebugWith(logger kallax.LoggerFunc) *SchemaFixtureS
 	return &SchemaFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *SchemaFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *SchemaFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *SchemaFixtureStore) WithTracer(tracer kallax.Tracer) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *SchemaFixtureStore) WithMetrics(sink kallax.MetricsSink) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *SchemaFixtureStore) WithRetry(policy kallax.RetryPolicy) *SchemaFixtureStore {
+	return &SchemaFixtureStore{s.Store.WithRetry(policy)}
+}
+
 func (s *SchemaFixtureStore) relationshipRecords(record *SchemaFixture) []kallax.RecordWithSchema {
 	var records []kallax.RecordWithSchema
 
@@ -6706,13 +11359,19 @@ func (s *SchemaFixtureStore) inverseRecords(record *SchemaFixture) []kallax.Reco
 // Insert inserts a SchemaFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a SchemaFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *SchemaFixtureStore) InsertContext(ctx context.Context, record *SchemaFixture) error {
 
 	records := s.relationshipRecords(record)
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 && len(inverseRecords) > 0 {
-		return s.Store.Transaction(func(s *kallax.Store) error {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -6720,7 +11379,7 @@ func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -6729,7 +11388,7 @@ func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
 				}
 			}
 
-			if err := s.Insert(Schema.SchemaFixture.BaseSchema, record); err != nil {
+			if err := s.InsertContext(ctx, Schema.SchemaFixture.BaseSchema, record); err != nil {
 				return err
 			}
 
@@ -6739,7 +11398,7 @@ func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -6752,8 +11411,101 @@ func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
 		})
 	}
 
-	return s.Store.Insert(Schema.SchemaFixture.BaseSchema, record)
+	return s.Store.InsertContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given SchemaFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *SchemaFixtureStore) InsertMany(records []*SchemaFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given SchemaFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *SchemaFixtureStore) InsertManyContext(ctx context.Context, records []*SchemaFixture) error {
+
+	var inverseRecords []kallax.RecordWithSchema
+
+	var relRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		inverseRecords = append(inverseRecords, s.inverseRecords(record)...)
+
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		for _, r := range inverseRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InsertManyContext(ctx, Schema.SchemaFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, r := range relRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the SchemaFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *SchemaFixtureStore) CopyFrom(records <-chan *SchemaFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the SchemaFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *SchemaFixtureStore) CopyFromContext(ctx context.Context, records <-chan *SchemaFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.SchemaFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *SchemaFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -6763,13 +11515,19 @@ func (s *SchemaFixtureStore) Insert(record *SchemaFixture) error {
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *SchemaFixtureStore) UpdateContext(ctx context.Context, record *SchemaFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
 	records := s.relationshipRecords(record)
 
 	inverseRecords := s.inverseRecords(record)
 
 	if len(records) > 0 && len(inverseRecords) > 0 {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
 
 			for _, r := range inverseRecords {
 				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
@@ -6777,7 +11535,7 @@ func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.Schema
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -6786,7 +11544,7 @@ func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.Schema
 				}
 			}
 
-			updated, err = s.Update(Schema.SchemaFixture.BaseSchema, record, cols...)
+			updated, err = s.UpdateContext(ctx, Schema.SchemaFixture.BaseSchema, record, cols...)
 			if err != nil {
 				return err
 			}
@@ -6797,7 +11555,7 @@ func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.Schema
 				}
 				persisted := r.Record.IsPersisted()
 
-				if _, err := s.Save(r.Schema, r.Record); err != nil {
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
 					return err
 				}
 
@@ -6815,18 +11573,25 @@ func (s *SchemaFixtureStore) Update(record *SchemaFixture, cols ...kallax.Schema
 		return updated, nil
 	}
 
-	return s.Store.Update(Schema.SchemaFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.SchemaFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *SchemaFixtureStore) Save(record *SchemaFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *SchemaFixtureStore) SaveContext(ctx context.Context, record *SchemaFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -6834,16 +11599,73 @@ func (s *SchemaFixtureStore) Save(record *SchemaFixture) (updated bool, err erro
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *SchemaFixtureStore) Upsert(record *SchemaFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *SchemaFixtureStore) UpsertContext(ctx context.Context, record *SchemaFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.SchemaFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *SchemaFixtureStore) Delete(record *SchemaFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *SchemaFixtureStore) DeleteContext(ctx context.Context, record *SchemaFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *SchemaFixtureStore) UpdateAll(q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
 
-	return s.Store.Delete(Schema.SchemaFixture.BaseSchema, record)
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *SchemaFixtureStore) UpdateAllContext(ctx context.Context, q *SchemaFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *SchemaFixtureStore) DeleteAll(q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *SchemaFixtureStore) DeleteAllContext(ctx context.Context, q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *SchemaFixtureStore) Find(q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *SchemaFixtureStore) FindContext(ctx context.Context, q *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6857,31 +11679,113 @@ func (s *SchemaFixtureStore) MustFind(q *SchemaFixtureQuery) *SchemaFixtureResul
 	return NewSchemaFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as SchemaFixture records.
+func (s *SchemaFixtureStore) FindInto(q *SchemaFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *SchemaFixtureStore) FindIntoContext(ctx context.Context, q *SchemaFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *SchemaFixtureStore) Count(q *SchemaFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *SchemaFixtureStore) CountContext(ctx context.Context, q *SchemaFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *SchemaFixtureStore) MustCount(q *SchemaFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
-// FindOne returns the first row returned by the given query.
-// `ErrNotFound` is returned if there are no results.
-func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, error) {
-	q.Limit(1)
-	q.Offset(0)
-	rs, err := s.Find(q)
-	if err != nil {
-		return nil, err
-	}
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *SchemaFixtureStore) Aggregate(q *SchemaFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
 
-	if !rs.Next() {
-		return nil, kallax.ErrNotFound
-	}
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *SchemaFixtureStore) AggregateContext(ctx context.Context, q *SchemaFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *SchemaFixtureStore) AggregateInto(q *SchemaFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *SchemaFixtureStore) AggregateIntoContext(ctx context.Context, q *SchemaFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// SumInt returns the sum of the Int property of the items
+// selected by the given query, or zero if there are none.
+func (s *SchemaFixtureStore) SumInt(q *SchemaFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.SchemaFixture.Int), &v)
+	return v, err
+}
+
+// AvgInt returns the average of the Int property of the items
+// selected by the given query, or zero if there are none.
+func (s *SchemaFixtureStore) AvgInt(q *SchemaFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.SchemaFixture.Int), &v)
+	return v, err
+}
+
+// MinInt returns the minimum value of the Int property of the
+// items selected by the given query, or zero if there are none.
+func (s *SchemaFixtureStore) MinInt(q *SchemaFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.SchemaFixture.Int), &v)
+	return v, err
+}
+
+// MaxInt returns the maximum value of the Int property of the
+// items selected by the given query, or zero if there are none.
+func (s *SchemaFixtureStore) MaxInt(q *SchemaFixtureQuery) (int, error) {
+	var v int
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.SchemaFixture.Int), &v)
+	return v, err
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *SchemaFixtureStore) FindOneContext(ctx context.Context, q *SchemaFixtureQuery) (*SchemaFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
 
 	record, err := rs.Get()
 	if err != nil {
@@ -6897,7 +11801,13 @@ func (s *SchemaFixtureStore) FindOne(q *SchemaFixtureQuery) (*SchemaFixture, err
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *SchemaFixtureStore) FindAll(q *SchemaFixtureQuery) ([]*SchemaFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *SchemaFixtureStore) FindAllContext(ctx context.Context, q *SchemaFixtureQuery) ([]*SchemaFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -6921,16 +11831,52 @@ func (s *SchemaFixtureStore) Reload(record *SchemaFixture) error {
 	return s.Store.Reload(Schema.SchemaFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the SchemaFixture with the data in the database using
+// the given context and makes it writable.
+func (s *SchemaFixtureStore) ReloadContext(ctx context.Context, record *SchemaFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SchemaFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *SchemaFixtureStore) Transaction(callback func(*SchemaFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaFixtureStore) TransactionContext(ctx context.Context, callback func(*SchemaFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&SchemaFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*SchemaFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*SchemaFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&SchemaFixtureStore{store})
 	})
 }
@@ -6938,6 +11884,13 @@ func (s *SchemaFixtureStore) Transaction(callback func(*SchemaFixtureStore) erro
 // RemoveNested removes from the database the given relationship of the
 // model. It also resets the field Nested of the model.
 func (s *SchemaFixtureStore) RemoveNested(record *SchemaFixture) error {
+	return s.RemoveNestedContext(context.Background(), record)
+}
+
+// RemoveNestedContext removes from the database the given relationship of
+// the model using the given context. It also resets the field Nested of
+// the model.
+func (s *SchemaFixtureStore) RemoveNestedContext(ctx context.Context, record *SchemaFixture) error {
 	var r kallax.Record = record.Nested
 	if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
 		if err := beforeDeleter.BeforeDelete(); err != nil {
@@ -6947,8 +11900,8 @@ func (s *SchemaFixtureStore) RemoveNested(record *SchemaFixture) error {
 
 	var err error
 	if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
-		err = s.Store.Transaction(func(s *kallax.Store) error {
-			err := s.Delete(Schema.SchemaFixture.BaseSchema, r)
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+			err := s.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, r)
 			if err != nil {
 				return err
 			}
@@ -6956,7 +11909,7 @@ func (s *SchemaFixtureStore) RemoveNested(record *SchemaFixture) error {
 			return afterDeleter.AfterDelete()
 		})
 	} else {
-		err = s.Store.Delete(Schema.SchemaFixture.BaseSchema, r)
+		err = s.Store.DeleteContext(ctx, Schema.SchemaFixture.BaseSchema, r)
 	}
 	if err != nil {
 		return err
@@ -7015,6 +11968,13 @@ func (q *SchemaFixtureQuery) BatchSize(size uint64) *SchemaFixtureQuery {
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *SchemaFixtureQuery) UseCursor() *SchemaFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *SchemaFixtureQuery) Limit(n uint64) *SchemaFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -7027,6 +11987,20 @@ func (q *SchemaFixtureQuery) Offset(n uint64) *SchemaFixtureQuery {
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SchemaFixtureQuery) After(cursor kallax.PageCursor) *SchemaFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SchemaFixtureQuery) Before(cursor kallax.PageCursor) *SchemaFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *SchemaFixtureQuery) Where(cond kallax.Condition) *SchemaFixtureQuery {
@@ -7034,6 +12008,55 @@ func (q *SchemaFixtureQuery) Where(cond kallax.Condition) *SchemaFixtureQuery {
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *SchemaFixtureQuery) GroupBy(fields ...kallax.SchemaField) *SchemaFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *SchemaFixtureQuery) Having(cond kallax.Condition) *SchemaFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *SchemaFixtureQuery) ForUpdate() *SchemaFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *SchemaFixtureQuery) ForShare() *SchemaFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *SchemaFixtureQuery) SkipLocked() *SchemaFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *SchemaFixtureQuery) NoWait() *SchemaFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *SchemaFixtureQuery) Of(schemas ...kallax.Schema) *SchemaFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 func (q *SchemaFixtureQuery) WithNested() *SchemaFixtureQuery {
 	q.AddRelation(Schema.SchemaFixture.BaseSchema, "Nested", kallax.OneToOne, nil)
 	return q
@@ -7044,6 +12067,16 @@ func (q *SchemaFixtureQuery) WithInverse() *SchemaFixtureQuery {
 	return q
 }
 
+// WhereInverseExist adds a condition to the query that requires that any of
+// the Inverse of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *SchemaFixtureQuery) WhereInverseExist(sub *SchemaRelationshipFixtureQuery) *SchemaFixtureQuery {
+	if sub == nil {
+		sub = NewSchemaRelationshipFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Inverse", sub))
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -7185,6 +12218,18 @@ func (rs *SchemaFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last SchemaFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *SchemaFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *SchemaFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
@@ -7245,6 +12290,12 @@ func NewSchemaRelationshipFixtureStore(db *sql.DB) *SchemaRelationshipFixtureSto
 	return &SchemaRelationshipFixtureStore{kallax.NewStore(db)}
 }
 
+// NewSchemaRelationshipFixtureStoreWithDialect creates a new instance of SchemaRelationshipFixtureStore
+// using a SQL database of the given dialect.
+func NewSchemaRelationshipFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
 // GenericStore returns the generic store of this store.
 func (s *SchemaRelationshipFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
@@ -7267,12 +12318,96 @@ func (s *SchemaRelationshipFixtureStore) DebugWith(logger kallax.LoggerFunc) *Sc
 	return &SchemaRelationshipFixtureStore{s.Store.DebugWith(logger)}
 }
 
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *SchemaRelationshipFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *SchemaRelationshipFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *SchemaRelationshipFixtureStore) WithTracer(tracer kallax.Tracer) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *SchemaRelationshipFixtureStore) WithMetrics(sink kallax.MetricsSink) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *SchemaRelationshipFixtureStore) WithRetry(policy kallax.RetryPolicy) *SchemaRelationshipFixtureStore {
+	return &SchemaRelationshipFixtureStore{s.Store.WithRetry(policy)}
+}
+
 // Insert inserts a SchemaRelationshipFixture in the database. A non-persisted object is
 // required for this operation.
 func (s *SchemaRelationshipFixtureStore) Insert(record *SchemaRelationshipFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a SchemaRelationshipFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *SchemaRelationshipFixtureStore) InsertContext(ctx context.Context, record *SchemaRelationshipFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given SchemaRelationshipFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *SchemaRelationshipFixtureStore) InsertMany(records []*SchemaRelationshipFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given SchemaRelationshipFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *SchemaRelationshipFixtureStore) InsertManyContext(ctx context.Context, records []*SchemaRelationshipFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, kallaxRecords...)
 
-	return s.Store.Insert(Schema.SchemaRelationshipFixture.BaseSchema, record)
+}
+
+// CopyFrom inserts the SchemaRelationshipFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *SchemaRelationshipFixtureStore) CopyFrom(records <-chan *SchemaRelationshipFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the SchemaRelationshipFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *SchemaRelationshipFixtureStore) CopyFromContext(ctx context.Context, records <-chan *SchemaRelationshipFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *SchemaRelationshipFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -7282,19 +12417,32 @@ func (s *SchemaRelationshipFixtureStore) Insert(record *SchemaRelationshipFixtur
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
 func (s *SchemaRelationshipFixtureStore) Update(record *SchemaRelationshipFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-	return s.Store.Update(Schema.SchemaRelationshipFixture.BaseSchema, record, cols...)
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *SchemaRelationshipFixtureStore) UpdateContext(ctx context.Context, record *SchemaRelationshipFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
 func (s *SchemaRelationshipFixtureStore) Save(record *SchemaRelationshipFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *SchemaRelationshipFixtureStore) SaveContext(ctx context.Context, record *SchemaRelationshipFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -7302,16 +12450,73 @@ func (s *SchemaRelationshipFixtureStore) Save(record *SchemaRelationshipFixture)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *SchemaRelationshipFixtureStore) Upsert(record *SchemaRelationshipFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *SchemaRelationshipFixtureStore) UpsertContext(ctx context.Context, record *SchemaRelationshipFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
 func (s *SchemaRelationshipFixtureStore) Delete(record *SchemaRelationshipFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *SchemaRelationshipFixtureStore) DeleteContext(ctx context.Context, record *SchemaRelationshipFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *SchemaRelationshipFixtureStore) UpdateAll(q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
 
-	return s.Store.Delete(Schema.SchemaRelationshipFixture.BaseSchema, record)
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *SchemaRelationshipFixtureStore) UpdateAllContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *SchemaRelationshipFixtureStore) DeleteAll(q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *SchemaRelationshipFixtureStore) DeleteAllContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
 func (s *SchemaRelationshipFixtureStore) Find(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *SchemaRelationshipFixtureStore) FindContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7325,24 +12530,74 @@ func (s *SchemaRelationshipFixtureStore) MustFind(q *SchemaRelationshipFixtureQu
 	return NewSchemaRelationshipFixtureResultSet(s.Store.MustFind(q))
 }
 
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as SchemaRelationshipFixture records.
+func (s *SchemaRelationshipFixtureStore) FindInto(q *SchemaRelationshipFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *SchemaRelationshipFixtureStore) FindIntoContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
 // Count returns the number of rows that would be retrieved with the given
 // query.
 func (s *SchemaRelationshipFixtureStore) Count(q *SchemaRelationshipFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *SchemaRelationshipFixtureStore) CountContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
 func (s *SchemaRelationshipFixtureStore) MustCount(q *SchemaRelationshipFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *SchemaRelationshipFixtureStore) Aggregate(q *SchemaRelationshipFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *SchemaRelationshipFixtureStore) AggregateContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *SchemaRelationshipFixtureStore) AggregateInto(q *SchemaRelationshipFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *SchemaRelationshipFixtureStore) AggregateIntoContext(ctx context.Context, q *SchemaRelationshipFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
 func (s *SchemaRelationshipFixtureStore) FindOne(q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *SchemaRelationshipFixtureStore) FindOneContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) (*SchemaRelationshipFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7365,7 +12620,13 @@ func (s *SchemaRelationshipFixtureStore) FindOne(q *SchemaRelationshipFixtureQue
 
 // FindAll returns a list of all the rows returned by the given query.
 func (s *SchemaRelationshipFixtureStore) FindAll(q *SchemaRelationshipFixtureQuery) ([]*SchemaRelationshipFixture, error) {
-	rs, err := s.Find(q)
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *SchemaRelationshipFixtureStore) FindAllContext(ctx context.Context, q *SchemaRelationshipFixtureQuery) ([]*SchemaRelationshipFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7389,16 +12650,52 @@ func (s *SchemaRelationshipFixtureStore) Reload(record *SchemaRelationshipFixtur
 	return s.Store.Reload(Schema.SchemaRelationshipFixture.BaseSchema, record)
 }
 
+// ReloadContext refreshes the SchemaRelationshipFixture with the data in the database using
+// the given context and makes it writable.
+func (s *SchemaRelationshipFixtureStore) ReloadContext(ctx context.Context, record *SchemaRelationshipFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SchemaRelationshipFixture.BaseSchema, record)
+}
+
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
 func (s *SchemaRelationshipFixtureStore) Transaction(callback func(*SchemaRelationshipFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaRelationshipFixtureStore) TransactionContext(ctx context.Context, callback func(*SchemaRelationshipFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&SchemaRelationshipFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaRelationshipFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*SchemaRelationshipFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SchemaRelationshipFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*SchemaRelationshipFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
 		return callback(&SchemaRelationshipFixtureStore{store})
 	})
 }
@@ -7452,6 +12749,13 @@ func (q *SchemaRelationshipFixtureQuery) BatchSize(size uint64) *SchemaRelations
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *SchemaRelationshipFixtureQuery) UseCursor() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
 func (q *SchemaRelationshipFixtureQuery) Limit(n uint64) *SchemaRelationshipFixtureQuery {
 	q.BaseQuery.Limit(n)
@@ -7464,6 +12768,20 @@ func (q *SchemaRelationshipFixtureQuery) Offset(n uint64) *SchemaRelationshipFix
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SchemaRelationshipFixtureQuery) After(cursor kallax.PageCursor) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SchemaRelationshipFixtureQuery) Before(cursor kallax.PageCursor) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
 func (q *SchemaRelationshipFixtureQuery) Where(cond kallax.Condition) *SchemaRelationshipFixtureQuery {
@@ -7471,6 +12789,55 @@ func (q *SchemaRelationshipFixtureQuery) Where(cond kallax.Condition) *SchemaRel
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *SchemaRelationshipFixtureQuery) GroupBy(fields ...kallax.SchemaField) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *SchemaRelationshipFixtureQuery) Having(cond kallax.Condition) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *SchemaRelationshipFixtureQuery) ForUpdate() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *SchemaRelationshipFixtureQuery) ForShare() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *SchemaRelationshipFixtureQuery) SkipLocked() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *SchemaRelationshipFixtureQuery) NoWait() *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *SchemaRelationshipFixtureQuery) Of(schemas ...kallax.Schema) *SchemaRelationshipFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
@@ -7588,106 +12955,2829 @@ func (rs *SchemaRelationshipFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last SchemaRelationshipFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *SchemaRelationshipFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
 func (rs *SchemaRelationshipFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreFixture returns a new instance of StoreFixture.
-func NewStoreFixture() (record *StoreFixture) {
-	return newStoreFixture()
+// NewSoftDeleteFixture returns a new instance of SoftDeleteFixture.
+func NewSoftDeleteFixture() (record *SoftDeleteFixture) {
+	return new(SoftDeleteFixture)
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *SoftDeleteFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *SoftDeleteFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
+		return (*kallax.NumericID)(&r.ID), nil
+	case "deleted_at":
+		return types.Nullable(&r.SoftDelete.DeletedAt), nil
 	case "foo":
 		return &r.Foo, nil
-	case "slice_prop":
-		return types.Slice(&r.SliceProp), nil
-	case "alias_slice_prop":
-		return types.Slice((*[]string)(&r.AliasSliceProp)), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreFixture) Value(col string) (interface{}, error) {
+func (r *SoftDeleteFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
+	case "deleted_at":
+		if r.SoftDelete.DeletedAt == (*time.Time)(nil) {
+			return nil, nil
+		}
+		return r.SoftDelete.DeletedAt, nil
 	case "foo":
 		return r.Foo, nil
-	case "slice_prop":
-		return types.Slice(r.SliceProp), nil
-	case "alias_slice_prop":
-		return types.Slice(r.AliasSliceProp), nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreFixture has no relationships")
+func (r *SoftDeleteFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	switch field {
+	case "Items":
+		return new(SoftDeleteItemFixture), nil
+
+	}
+	return nil, fmt.Errorf("kallax: model SoftDeleteFixture has no relationship %s", field)
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreFixture has no relationships")
+func (r *SoftDeleteFixture) SetRelationship(field string, rel interface{}) error {
+	switch field {
+	case "Items":
+		records, ok := rel.([]kallax.Record)
+		if !ok {
+			return fmt.Errorf("kallax: relationship field %s needs a collection of records, not %T", field, rel)
+		}
+
+		r.Items = make([]*SoftDeleteItemFixture, len(records))
+		for i, record := range records {
+			rel, ok := record.(*SoftDeleteItemFixture)
+			if !ok {
+				return fmt.Errorf("kallax: element of type %T cannot be added to relationship %s", record, field)
+			}
+			r.Items[i] = rel
+		}
+		return nil
+
+	}
+	return fmt.Errorf("kallax: model SoftDeleteFixture has no relationship %s", field)
+}
+
+// SoftDeleteFixtureStore is the entity to access the records of the type SoftDeleteFixture
+// in the database.
+type SoftDeleteFixtureStore struct {
+	*kallax.Store
+}
+
+// NewSoftDeleteFixtureStore creates a new instance of SoftDeleteFixtureStore
+// using a SQL database.
+func NewSoftDeleteFixtureStore(db *sql.DB) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{kallax.NewStore(db)}
+}
+
+// NewSoftDeleteFixtureStoreWithDialect creates a new instance of SoftDeleteFixtureStore
+// using a SQL database of the given dialect.
+func NewSoftDeleteFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *SoftDeleteFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *SoftDeleteFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *SoftDeleteFixtureStore) Debug() *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *SoftDeleteFixtureStore) DebugWith(logger kallax.LoggerFunc) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *SoftDeleteFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *SoftDeleteFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *SoftDeleteFixtureStore) WithTracer(tracer kallax.Tracer) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *SoftDeleteFixtureStore) WithMetrics(sink kallax.MetricsSink) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *SoftDeleteFixtureStore) WithRetry(policy kallax.RetryPolicy) *SoftDeleteFixtureStore {
+	return &SoftDeleteFixtureStore{s.Store.WithRetry(policy)}
+}
+
+func (s *SoftDeleteFixtureStore) relationshipRecords(record *SoftDeleteFixture) []kallax.RecordWithSchema {
+	var records []kallax.RecordWithSchema
+
+	for _, rec := range record.Items {
+		rec.ClearVirtualColumns()
+		rec.AddVirtualColumn("owner_id", record.GetID())
+		records = append(records, kallax.RecordWithSchema{
+			Schema: Schema.SoftDeleteItemFixture.BaseSchema,
+			Record: rec,
+		})
+	}
+
+	return records
+}
+
+// Insert inserts a SoftDeleteFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *SoftDeleteFixtureStore) Insert(record *SoftDeleteFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a SoftDeleteFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *SoftDeleteFixtureStore) InsertContext(ctx context.Context, record *SoftDeleteFixture) error {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	records := s.relationshipRecords(record)
+
+	if len(records) > 0 {
+		return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+			if err := s.InsertContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record); err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+					return err
+				}
+				persisted := r.Record.IsPersisted()
+
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+					return err
+				}
+
+				if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return s.Store.InsertContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given SoftDeleteFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *SoftDeleteFixtureStore) InsertMany(records []*SoftDeleteFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given SoftDeleteFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *SoftDeleteFixtureStore) InsertManyContext(ctx context.Context, records []*SoftDeleteFixture) error {
+
+	var relRecords []kallax.RecordWithSchema
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+		if record.DeletedAt != nil {
+			record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+		}
+
+		relRecords = append(relRecords, s.relationshipRecords(record)...)
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+		if err := s.InsertManyContext(ctx, Schema.SoftDeleteFixture.BaseSchema, kallaxRecords...); err != nil {
+			return err
+		}
+
+		for _, r := range relRecords {
+			if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+				return err
+			}
+			persisted := r.Record.IsPersisted()
+
+			if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+				return err
+			}
+
+			if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+}
+
+// CopyFrom inserts the SoftDeleteFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *SoftDeleteFixtureStore) CopyFrom(records <-chan *SoftDeleteFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the SoftDeleteFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *SoftDeleteFixtureStore) CopyFromContext(ctx context.Context, records <-chan *SoftDeleteFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.SoftDeleteFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *SoftDeleteFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if record.DeletedAt != nil {
+			record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+		}
+
+		return record, nil
+	}))
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *SoftDeleteFixtureStore) Update(record *SoftDeleteFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *SoftDeleteFixtureStore) UpdateContext(ctx context.Context, record *SoftDeleteFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	records := s.relationshipRecords(record)
+
+	if len(records) > 0 {
+		err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+
+			updated, err = s.UpdateContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record, cols...)
+			if err != nil {
+				return err
+			}
+
+			for _, r := range records {
+				if err := kallax.ApplyBeforeEvents(r.Record); err != nil {
+					return err
+				}
+				persisted := r.Record.IsPersisted()
+
+				if _, err := s.SaveContext(ctx, r.Schema, r.Record); err != nil {
+					return err
+				}
+
+				if err := kallax.ApplyAfterEvents(r.Record, persisted); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return updated, nil
+	}
+
+	return s.Store.UpdateContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record, cols...)
+
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *SoftDeleteFixtureStore) Save(record *SoftDeleteFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *SoftDeleteFixtureStore) SaveContext(ctx context.Context, record *SoftDeleteFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *SoftDeleteFixtureStore) Upsert(record *SoftDeleteFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *SoftDeleteFixtureStore) UpsertContext(ctx context.Context, record *SoftDeleteFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.UpsertContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
+// Delete removes the given record from the database.
+// The record is not removed but marked as deleted. Use HardDelete to remove it.
+func (s *SoftDeleteFixtureStore) Delete(record *SoftDeleteFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *SoftDeleteFixtureStore) DeleteContext(ctx context.Context, record *SoftDeleteFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+
+}
+
+// HardDelete removes the given record from the database, instead of marking
+// it as deleted.
+func (s *SoftDeleteFixtureStore) HardDelete(record *SoftDeleteFixture) error {
+	return s.HardDeleteContext(context.Background(), record)
+}
+
+// HardDeleteContext removes the given record from the database using the
+// given context, instead of marking it as deleted.
+func (s *SoftDeleteFixtureStore) HardDeleteContext(ctx context.Context, record *SoftDeleteFixture) error {
+
+	return s.Store.HardDeleteContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *SoftDeleteFixtureStore) UpdateAll(q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *SoftDeleteFixtureStore) UpdateAllContext(ctx context.Context, q *SoftDeleteFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *SoftDeleteFixtureStore) DeleteAll(q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *SoftDeleteFixtureStore) DeleteAllContext(ctx context.Context, q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
+// Find returns the set of results for the given query.
+func (s *SoftDeleteFixtureStore) Find(q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *SoftDeleteFixtureStore) FindContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftDeleteFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *SoftDeleteFixtureStore) MustFind(q *SoftDeleteFixtureQuery) *SoftDeleteFixtureResultSet {
+	return NewSoftDeleteFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as SoftDeleteFixture records.
+func (s *SoftDeleteFixtureStore) FindInto(q *SoftDeleteFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *SoftDeleteFixtureStore) FindIntoContext(ctx context.Context, q *SoftDeleteFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *SoftDeleteFixtureStore) Count(q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *SoftDeleteFixtureStore) CountContext(ctx context.Context, q *SoftDeleteFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *SoftDeleteFixtureStore) MustCount(q *SoftDeleteFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *SoftDeleteFixtureStore) Aggregate(q *SoftDeleteFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *SoftDeleteFixtureStore) AggregateContext(ctx context.Context, q *SoftDeleteFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *SoftDeleteFixtureStore) AggregateInto(q *SoftDeleteFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *SoftDeleteFixtureStore) AggregateIntoContext(ctx context.Context, q *SoftDeleteFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *SoftDeleteFixtureStore) FindOne(q *SoftDeleteFixtureQuery) (*SoftDeleteFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *SoftDeleteFixtureStore) FindOneContext(ctx context.Context, q *SoftDeleteFixtureQuery) (*SoftDeleteFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *SoftDeleteFixtureStore) FindAll(q *SoftDeleteFixtureQuery) ([]*SoftDeleteFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *SoftDeleteFixtureStore) FindAllContext(ctx context.Context, q *SoftDeleteFixtureQuery) ([]*SoftDeleteFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *SoftDeleteFixtureStore) MustFindOne(q *SoftDeleteFixtureQuery) *SoftDeleteFixture {
+	record, err := s.FindOne(q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the SoftDeleteFixture with the data in the database and
+// makes it writable.
+func (s *SoftDeleteFixtureStore) Reload(record *SoftDeleteFixture) error {
+	return s.Store.Reload(Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the SoftDeleteFixture with the data in the database using
+// the given context and makes it writable.
+func (s *SoftDeleteFixtureStore) ReloadContext(ctx context.Context, record *SoftDeleteFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SoftDeleteFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteFixtureStore) Transaction(callback func(*SoftDeleteFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteFixtureStore) TransactionContext(ctx context.Context, callback func(*SoftDeleteFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&SoftDeleteFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*SoftDeleteFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*SoftDeleteFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&SoftDeleteFixtureStore{store})
+	})
+}
+
+// RemoveItems removes the given items of the Items field of the
+// model. If no items are given, it removes all of them.
+// The items will also be removed from the passed record inside this method.
+func (s *SoftDeleteFixtureStore) RemoveItems(record *SoftDeleteFixture, deleted ...*SoftDeleteItemFixture) error {
+	return s.RemoveItemsContext(context.Background(), record, deleted...)
+}
+
+// RemoveItemsContext removes the given items of the Items field of the
+// model using the given context. If no items are given, it removes all of
+// them.
+// The items will also be removed from the passed record inside this method.
+func (s *SoftDeleteFixtureStore) RemoveItemsContext(ctx context.Context, record *SoftDeleteFixture, deleted ...*SoftDeleteItemFixture) error {
+	var updated []*SoftDeleteItemFixture
+	var clear bool
+	if len(deleted) == 0 {
+		clear = true
+		deleted = record.Items
+		if len(deleted) == 0 {
+			return nil
+		}
+	}
+
+	if len(deleted) > 1 {
+		err := s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+			for _, d := range deleted {
+				var r kallax.Record = d
+
+				if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+					if err := beforeDeleter.BeforeDelete(); err != nil {
+						return err
+					}
+				}
+
+				if err := s.DeleteContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, d); err != nil {
+					return err
+				}
+
+				if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+					if err := afterDeleter.AfterDelete(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if clear {
+			record.Items = nil
+			return nil
+		}
+	} else {
+		var r kallax.Record = deleted[0]
+		if beforeDeleter, ok := r.(kallax.BeforeDeleter); ok {
+			if err := beforeDeleter.BeforeDelete(); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if afterDeleter, ok := r.(kallax.AfterDeleter); ok {
+			err = s.Store.TransactionContext(ctx, func(s *kallax.Store) error {
+				err := s.DeleteContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, r)
+				if err != nil {
+					return err
+				}
+
+				return afterDeleter.AfterDelete()
+			})
+		} else {
+			err = s.Store.DeleteContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, deleted[0])
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, r := range record.Items {
+		var found bool
+		for _, d := range deleted {
+			if d.GetID().Equals(r.GetID()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, r)
+		}
+	}
+	record.Items = updated
+	return nil
+}
+
+// SoftDeleteFixtureQuery is the object used to create queries for the SoftDeleteFixture
+// entity.
+type SoftDeleteFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewSoftDeleteFixtureQuery returns a new instance of SoftDeleteFixtureQuery.
+func NewSoftDeleteFixtureQuery() *SoftDeleteFixtureQuery {
+	return &SoftDeleteFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.SoftDeleteFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *SoftDeleteFixtureQuery) Select(columns ...kallax.SchemaField) *SoftDeleteFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *SoftDeleteFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SoftDeleteFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *SoftDeleteFixtureQuery) Copy() *SoftDeleteFixtureQuery {
+	return &SoftDeleteFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *SoftDeleteFixtureQuery) Order(cols ...kallax.ColumnOrder) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *SoftDeleteFixtureQuery) BatchSize(size uint64) *SoftDeleteFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *SoftDeleteFixtureQuery) UseCursor() *SoftDeleteFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *SoftDeleteFixtureQuery) Limit(n uint64) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *SoftDeleteFixtureQuery) Offset(n uint64) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SoftDeleteFixtureQuery) After(cursor kallax.PageCursor) *SoftDeleteFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SoftDeleteFixtureQuery) Before(cursor kallax.PageCursor) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *SoftDeleteFixtureQuery) Where(cond kallax.Condition) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *SoftDeleteFixtureQuery) GroupBy(fields ...kallax.SchemaField) *SoftDeleteFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *SoftDeleteFixtureQuery) Having(cond kallax.Condition) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *SoftDeleteFixtureQuery) ForUpdate() *SoftDeleteFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *SoftDeleteFixtureQuery) ForShare() *SoftDeleteFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *SoftDeleteFixtureQuery) SkipLocked() *SoftDeleteFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *SoftDeleteFixtureQuery) NoWait() *SoftDeleteFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *SoftDeleteFixtureQuery) Of(schemas ...kallax.Schema) *SoftDeleteFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+// WithDeleted makes the query retrieve the soft deleted items as well, which
+// are excluded by default.
+func (q *SoftDeleteFixtureQuery) WithDeleted() *SoftDeleteFixtureQuery {
+	q.BaseQuery.WithDeleted()
+	return q
+}
+
+// OnlyDeleted makes the query retrieve only the soft deleted items.
+func (q *SoftDeleteFixtureQuery) OnlyDeleted() *SoftDeleteFixtureQuery {
+	q.BaseQuery.OnlyDeleted()
+	return q
+}
+
+func (q *SoftDeleteFixtureQuery) WithItems(cond kallax.Condition) *SoftDeleteFixtureQuery {
+	q.AddRelation(Schema.SoftDeleteItemFixture.BaseSchema, "Items", kallax.OneToMany, cond)
+	return q
+}
+
+// WhereItemsExist adds a condition to the query that requires that any of
+// the Items of the items is selected by the given query, or that there is
+// any at all if it is nil.
+func (q *SoftDeleteFixtureQuery) WhereItemsExist(sub *SoftDeleteItemFixtureQuery) *SoftDeleteFixtureQuery {
+	if sub == nil {
+		sub = NewSoftDeleteItemFixtureQuery()
+	}
+	return q.Where(kallax.ExistsRelated("Items", sub))
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *SoftDeleteFixtureQuery) FindByID(v ...int64) *SoftDeleteFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.SoftDeleteFixture.ID, values...))
+}
+
+// FindByDeletedAt adds a new filter to the query that will require that
+// the DeletedAt property is equal to the passed value.
+func (q *SoftDeleteFixtureQuery) FindByDeletedAt(cond kallax.ScalarCond, v time.Time) *SoftDeleteFixtureQuery {
+	return q.Where(cond(Schema.SoftDeleteFixture.DeletedAt, v))
+}
+
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *SoftDeleteFixtureQuery) FindByFoo(v string) *SoftDeleteFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SoftDeleteFixture.Foo, v))
+}
+
+// SoftDeleteFixtureResultSet is the set of results returned by a query to the
+// database.
+type SoftDeleteFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *SoftDeleteFixture
+	lastErr   error
+}
+
+// NewSoftDeleteFixtureResultSet creates a new result set for rows of the type
+// SoftDeleteFixture.
+func NewSoftDeleteFixtureResultSet(rs kallax.ResultSet) *SoftDeleteFixtureResultSet {
+	return &SoftDeleteFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *SoftDeleteFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.SoftDeleteFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*SoftDeleteFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SoftDeleteFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *SoftDeleteFixtureResultSet) Get() (*SoftDeleteFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *SoftDeleteFixtureResultSet) ForEach(fn func(*SoftDeleteFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *SoftDeleteFixtureResultSet) All() ([]*SoftDeleteFixture, error) {
+	var result []*SoftDeleteFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *SoftDeleteFixtureResultSet) One() (*SoftDeleteFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Err returns the last error occurred.
+func (rs *SoftDeleteFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Cursor returns the page cursor of the last SoftDeleteFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *SoftDeleteFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
+// Close closes the result set.
+func (rs *SoftDeleteFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewSoftDeleteItemFixture returns a new instance of SoftDeleteItemFixture.
+func NewSoftDeleteItemFixture() (record *SoftDeleteItemFixture) {
+	return new(SoftDeleteItemFixture)
+}
+
+// GetID returns the primary key of the model.
+func (r *SoftDeleteItemFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *SoftDeleteItemFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.NumericID)(&r.ID), nil
+	case "deleted_at":
+		return types.Nullable(&r.SoftDelete.DeletedAt), nil
+	case "name":
+		return &r.Name, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteItemFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *SoftDeleteItemFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "deleted_at":
+		if r.SoftDelete.DeletedAt == (*time.Time)(nil) {
+			return nil, nil
+		}
+		return r.SoftDelete.DeletedAt, nil
+	case "name":
+		return r.Name, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in SoftDeleteItemFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *SoftDeleteItemFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model SoftDeleteItemFixture has no relationships")
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *SoftDeleteItemFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model SoftDeleteItemFixture has no relationships")
+}
+
+// SoftDeleteItemFixtureStore is the entity to access the records of the type SoftDeleteItemFixture
+// in the database.
+type SoftDeleteItemFixtureStore struct {
+	*kallax.Store
+}
+
+// NewSoftDeleteItemFixtureStore creates a new instance of SoftDeleteItemFixtureStore
+// using a SQL database.
+func NewSoftDeleteItemFixtureStore(db *sql.DB) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{kallax.NewStore(db)}
+}
+
+// NewSoftDeleteItemFixtureStoreWithDialect creates a new instance of SoftDeleteItemFixtureStore
+// using a SQL database of the given dialect.
+func NewSoftDeleteItemFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *SoftDeleteItemFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *SoftDeleteItemFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *SoftDeleteItemFixtureStore) Debug() *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *SoftDeleteItemFixtureStore) DebugWith(logger kallax.LoggerFunc) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *SoftDeleteItemFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *SoftDeleteItemFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *SoftDeleteItemFixtureStore) WithTracer(tracer kallax.Tracer) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *SoftDeleteItemFixtureStore) WithMetrics(sink kallax.MetricsSink) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *SoftDeleteItemFixtureStore) WithRetry(policy kallax.RetryPolicy) *SoftDeleteItemFixtureStore {
+	return &SoftDeleteItemFixtureStore{s.Store.WithRetry(policy)}
+}
+
+// Insert inserts a SoftDeleteItemFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *SoftDeleteItemFixtureStore) Insert(record *SoftDeleteItemFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a SoftDeleteItemFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *SoftDeleteItemFixtureStore) InsertContext(ctx context.Context, record *SoftDeleteItemFixture) error {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.InsertContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given SoftDeleteItemFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *SoftDeleteItemFixtureStore) InsertMany(records []*SoftDeleteItemFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given SoftDeleteItemFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *SoftDeleteItemFixtureStore) InsertManyContext(ctx context.Context, records []*SoftDeleteItemFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+		if record.DeletedAt != nil {
+			record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+		}
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, kallaxRecords...)
+
+}
+
+// CopyFrom inserts the SoftDeleteItemFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *SoftDeleteItemFixtureStore) CopyFrom(records <-chan *SoftDeleteItemFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the SoftDeleteItemFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *SoftDeleteItemFixtureStore) CopyFromContext(ctx context.Context, records <-chan *SoftDeleteItemFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *SoftDeleteItemFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		if record.DeletedAt != nil {
+			record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+		}
+
+		return record, nil
+	}))
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *SoftDeleteItemFixtureStore) Update(record *SoftDeleteItemFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *SoftDeleteItemFixtureStore) UpdateContext(ctx context.Context, record *SoftDeleteItemFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.UpdateContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record, cols...)
+
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *SoftDeleteItemFixtureStore) Save(record *SoftDeleteItemFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *SoftDeleteItemFixtureStore) SaveContext(ctx context.Context, record *SoftDeleteItemFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *SoftDeleteItemFixtureStore) Upsert(record *SoftDeleteItemFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *SoftDeleteItemFixtureStore) UpsertContext(ctx context.Context, record *SoftDeleteItemFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	if record.DeletedAt != nil {
+		record.DeletedAt = func(t time.Time) *time.Time { return &t }(record.DeletedAt.Truncate(time.Microsecond))
+	}
+
+	return s.Store.UpsertContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
+// Delete removes the given record from the database.
+// The record is not removed but marked as deleted. Use HardDelete to remove it.
+func (s *SoftDeleteItemFixtureStore) Delete(record *SoftDeleteItemFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *SoftDeleteItemFixtureStore) DeleteContext(ctx context.Context, record *SoftDeleteItemFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record)
+
+}
+
+// HardDelete removes the given record from the database, instead of marking
+// it as deleted.
+func (s *SoftDeleteItemFixtureStore) HardDelete(record *SoftDeleteItemFixture) error {
+	return s.HardDeleteContext(context.Background(), record)
+}
+
+// HardDeleteContext removes the given record from the database using the
+// given context, instead of marking it as deleted.
+func (s *SoftDeleteItemFixtureStore) HardDeleteContext(ctx context.Context, record *SoftDeleteItemFixture) error {
+
+	return s.Store.HardDeleteContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *SoftDeleteItemFixtureStore) UpdateAll(q *SoftDeleteItemFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *SoftDeleteItemFixtureStore) UpdateAllContext(ctx context.Context, q *SoftDeleteItemFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *SoftDeleteItemFixtureStore) DeleteAll(q *SoftDeleteItemFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *SoftDeleteItemFixtureStore) DeleteAllContext(ctx context.Context, q *SoftDeleteItemFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
+// Find returns the set of results for the given query.
+func (s *SoftDeleteItemFixtureStore) Find(q *SoftDeleteItemFixtureQuery) (*SoftDeleteItemFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *SoftDeleteItemFixtureStore) FindContext(ctx context.Context, q *SoftDeleteItemFixtureQuery) (*SoftDeleteItemFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftDeleteItemFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *SoftDeleteItemFixtureStore) MustFind(q *SoftDeleteItemFixtureQuery) *SoftDeleteItemFixtureResultSet {
+	return NewSoftDeleteItemFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as SoftDeleteItemFixture records.
+func (s *SoftDeleteItemFixtureStore) FindInto(q *SoftDeleteItemFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *SoftDeleteItemFixtureStore) FindIntoContext(ctx context.Context, q *SoftDeleteItemFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *SoftDeleteItemFixtureStore) Count(q *SoftDeleteItemFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *SoftDeleteItemFixtureStore) CountContext(ctx context.Context, q *SoftDeleteItemFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *SoftDeleteItemFixtureStore) MustCount(q *SoftDeleteItemFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *SoftDeleteItemFixtureStore) Aggregate(q *SoftDeleteItemFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *SoftDeleteItemFixtureStore) AggregateContext(ctx context.Context, q *SoftDeleteItemFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *SoftDeleteItemFixtureStore) AggregateInto(q *SoftDeleteItemFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *SoftDeleteItemFixtureStore) AggregateIntoContext(ctx context.Context, q *SoftDeleteItemFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *SoftDeleteItemFixtureStore) FindOne(q *SoftDeleteItemFixtureQuery) (*SoftDeleteItemFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *SoftDeleteItemFixtureStore) FindOneContext(ctx context.Context, q *SoftDeleteItemFixtureQuery) (*SoftDeleteItemFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *SoftDeleteItemFixtureStore) FindAll(q *SoftDeleteItemFixtureQuery) ([]*SoftDeleteItemFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *SoftDeleteItemFixtureStore) FindAllContext(ctx context.Context, q *SoftDeleteItemFixtureQuery) ([]*SoftDeleteItemFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *SoftDeleteItemFixtureStore) MustFindOne(q *SoftDeleteItemFixtureQuery) *SoftDeleteItemFixture {
+	record, err := s.FindOne(q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the SoftDeleteItemFixture with the data in the database and
+// makes it writable.
+func (s *SoftDeleteItemFixtureStore) Reload(record *SoftDeleteItemFixture) error {
+	return s.Store.Reload(Schema.SoftDeleteItemFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the SoftDeleteItemFixture with the data in the database using
+// the given context and makes it writable.
+func (s *SoftDeleteItemFixtureStore) ReloadContext(ctx context.Context, record *SoftDeleteItemFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.SoftDeleteItemFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteItemFixtureStore) Transaction(callback func(*SoftDeleteItemFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteItemFixtureStore) TransactionContext(ctx context.Context, callback func(*SoftDeleteItemFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&SoftDeleteItemFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteItemFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*SoftDeleteItemFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *SoftDeleteItemFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*SoftDeleteItemFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&SoftDeleteItemFixtureStore{store})
+	})
+}
+
+// SoftDeleteItemFixtureQuery is the object used to create queries for the SoftDeleteItemFixture
+// entity.
+type SoftDeleteItemFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewSoftDeleteItemFixtureQuery returns a new instance of SoftDeleteItemFixtureQuery.
+func NewSoftDeleteItemFixtureQuery() *SoftDeleteItemFixtureQuery {
+	return &SoftDeleteItemFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.SoftDeleteItemFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *SoftDeleteItemFixtureQuery) Select(columns ...kallax.SchemaField) *SoftDeleteItemFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *SoftDeleteItemFixtureQuery) SelectNot(columns ...kallax.SchemaField) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *SoftDeleteItemFixtureQuery) Copy() *SoftDeleteItemFixtureQuery {
+	return &SoftDeleteItemFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *SoftDeleteItemFixtureQuery) Order(cols ...kallax.ColumnOrder) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *SoftDeleteItemFixtureQuery) BatchSize(size uint64) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *SoftDeleteItemFixtureQuery) UseCursor() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *SoftDeleteItemFixtureQuery) Limit(n uint64) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *SoftDeleteItemFixtureQuery) Offset(n uint64) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SoftDeleteItemFixtureQuery) After(cursor kallax.PageCursor) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *SoftDeleteItemFixtureQuery) Before(cursor kallax.PageCursor) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *SoftDeleteItemFixtureQuery) Where(cond kallax.Condition) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *SoftDeleteItemFixtureQuery) GroupBy(fields ...kallax.SchemaField) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *SoftDeleteItemFixtureQuery) Having(cond kallax.Condition) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *SoftDeleteItemFixtureQuery) ForUpdate() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *SoftDeleteItemFixtureQuery) ForShare() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *SoftDeleteItemFixtureQuery) SkipLocked() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *SoftDeleteItemFixtureQuery) NoWait() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *SoftDeleteItemFixtureQuery) Of(schemas ...kallax.Schema) *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+// WithDeleted makes the query retrieve the soft deleted items as well, which
+// are excluded by default.
+func (q *SoftDeleteItemFixtureQuery) WithDeleted() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.WithDeleted()
+	return q
+}
+
+// OnlyDeleted makes the query retrieve only the soft deleted items.
+func (q *SoftDeleteItemFixtureQuery) OnlyDeleted() *SoftDeleteItemFixtureQuery {
+	q.BaseQuery.OnlyDeleted()
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *SoftDeleteItemFixtureQuery) FindByID(v ...int64) *SoftDeleteItemFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.SoftDeleteItemFixture.ID, values...))
+}
+
+// FindByDeletedAt adds a new filter to the query that will require that
+// the DeletedAt property is equal to the passed value.
+func (q *SoftDeleteItemFixtureQuery) FindByDeletedAt(cond kallax.ScalarCond, v time.Time) *SoftDeleteItemFixtureQuery {
+	return q.Where(cond(Schema.SoftDeleteItemFixture.DeletedAt, v))
+}
+
+// FindByName adds a new filter to the query that will require that
+// the Name property is equal to the passed value.
+func (q *SoftDeleteItemFixtureQuery) FindByName(v string) *SoftDeleteItemFixtureQuery {
+	return q.Where(kallax.Eq(Schema.SoftDeleteItemFixture.Name, v))
+}
+
+// SoftDeleteItemFixtureResultSet is the set of results returned by a query to the
+// database.
+type SoftDeleteItemFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *SoftDeleteItemFixture
+	lastErr   error
+}
+
+// NewSoftDeleteItemFixtureResultSet creates a new result set for rows of the type
+// SoftDeleteItemFixture.
+func NewSoftDeleteItemFixtureResultSet(rs kallax.ResultSet) *SoftDeleteItemFixtureResultSet {
+	return &SoftDeleteItemFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *SoftDeleteItemFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.SoftDeleteItemFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*SoftDeleteItemFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *SoftDeleteItemFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *SoftDeleteItemFixtureResultSet) Get() (*SoftDeleteItemFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *SoftDeleteItemFixtureResultSet) ForEach(fn func(*SoftDeleteItemFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *SoftDeleteItemFixtureResultSet) All() ([]*SoftDeleteItemFixture, error) {
+	var result []*SoftDeleteItemFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *SoftDeleteItemFixtureResultSet) One() (*SoftDeleteItemFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Err returns the last error occurred.
+func (rs *SoftDeleteItemFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Cursor returns the page cursor of the last SoftDeleteItemFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *SoftDeleteItemFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
+// Close closes the result set.
+func (rs *SoftDeleteItemFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewStoreFixture returns a new instance of StoreFixture.
+func NewStoreFixture() (record *StoreFixture) {
+	return newStoreFixture()
+}
+
+// GetID returns the primary key of the model.
+func (r *StoreFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *StoreFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "foo":
+		return &r.Foo, nil
+	case "slice_prop":
+		return types.Slice(&r.SliceProp), nil
+	case "alias_slice_prop":
+		return types.Slice((*[]string)(&r.AliasSliceProp)), nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *StoreFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "foo":
+		return r.Foo, nil
+	case "slice_prop":
+		return types.Slice(r.SliceProp), nil
+	case "alias_slice_prop":
+		return types.Slice(r.AliasSliceProp), nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in StoreFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *StoreFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreFixture has no relationships")
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *StoreFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreFixture has no relationships")
 }
 
 // StoreFixtureStore is the entity to access the records of the type StoreFixture
 // in the database.
-type StoreFixtureStore struct {
+type StoreFixtureStore struct {
+	*kallax.Store
+}
+
+// NewStoreFixtureStore creates a new instance of StoreFixtureStore
+// using a SQL database.
+func NewStoreFixtureStore(db *sql.DB) *StoreFixtureStore {
+	return &StoreFixtureStore{kallax.NewStore(db)}
+}
+
+// NewStoreFixtureStoreWithDialect creates a new instance of StoreFixtureStore
+// using a SQL database of the given dialect.
+func NewStoreFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *StoreFixtureStore {
+	return &StoreFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *StoreFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *StoreFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *StoreFixtureStore) Debug() *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *StoreFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *StoreFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *StoreFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *StoreFixtureStore) WithTracer(tracer kallax.Tracer) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *StoreFixtureStore) WithMetrics(sink kallax.MetricsSink) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *StoreFixtureStore) WithRetry(policy kallax.RetryPolicy) *StoreFixtureStore {
+	return &StoreFixtureStore{s.Store.WithRetry(policy)}
+}
+
+// Insert inserts a StoreFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a StoreFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *StoreFixtureStore) InsertContext(ctx context.Context, record *StoreFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.StoreFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given StoreFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *StoreFixtureStore) InsertMany(records []*StoreFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given StoreFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *StoreFixtureStore) InsertManyContext(ctx context.Context, records []*StoreFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreFixture.BaseSchema, kallaxRecords...)
+
+}
+
+// CopyFrom inserts the StoreFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *StoreFixtureStore) CopyFrom(records <-chan *StoreFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the StoreFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *StoreFixtureStore) CopyFromContext(ctx context.Context, records <-chan *StoreFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.StoreFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *StoreFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
+
+		return record, nil
+	}))
+}
+
+// Update updates the given record on the database. If the columns are given,
+// only these columns will be updated. Otherwise all of them will be.
+// Be very careful with this, as you will have a potentially different object
+// in memory but not on the database.
+// Only writable records can be updated. Writable objects are those that have
+// been just inserted or retrieved using a query with no custom select fields.
+func (s *StoreFixtureStore) Update(record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *StoreFixtureStore) UpdateContext(ctx context.Context, record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.StoreFixture.BaseSchema, record, cols...)
+
+}
+
+// Save inserts the object if the record is not persisted, otherwise it updates
+// it. Same rules of Update and Insert apply depending on the case.
+func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *StoreFixtureStore) SaveContext(ctx context.Context, record *StoreFixture) (updated bool, err error) {
+	if !record.IsPersisted() {
+		return false, s.InsertContext(ctx, record)
+	}
+
+	rowsUpdated, err := s.UpdateContext(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	return rowsUpdated > 0, nil
+}
+
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *StoreFixtureStore) Upsert(record *StoreFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *StoreFixtureStore) UpsertContext(ctx context.Context, record *StoreFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.StoreFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
+// Delete removes the given record from the database.
+func (s *StoreFixtureStore) Delete(record *StoreFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *StoreFixtureStore) DeleteContext(ctx context.Context, record *StoreFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.StoreFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *StoreFixtureStore) UpdateAll(q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *StoreFixtureStore) UpdateAllContext(ctx context.Context, q *StoreFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *StoreFixtureStore) DeleteAll(q *StoreFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
+
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *StoreFixtureStore) DeleteAllContext(ctx context.Context, q *StoreFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
+}
+
+// Find returns the set of results for the given query.
+func (s *StoreFixtureStore) Find(q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *StoreFixtureStore) FindContext(ctx context.Context, q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStoreFixtureResultSet(rs), nil
+}
+
+// MustFind returns the set of results for the given query, but panics if there
+// is any error.
+func (s *StoreFixtureStore) MustFind(q *StoreFixtureQuery) *StoreFixtureResultSet {
+	return NewStoreFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as StoreFixture records.
+func (s *StoreFixtureStore) FindInto(q *StoreFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *StoreFixtureStore) FindIntoContext(ctx context.Context, q *StoreFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
+}
+
+// Count returns the number of rows that would be retrieved with the given
+// query.
+func (s *StoreFixtureStore) Count(q *StoreFixtureQuery) (int64, error) {
+	return s.Store.Count(q)
+}
+
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *StoreFixtureStore) CountContext(ctx context.Context, q *StoreFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
+// MustCount returns the number of rows that would be retrieved with the given
+// query, but panics if there is an error.
+func (s *StoreFixtureStore) MustCount(q *StoreFixtureQuery) int64 {
+	return s.Store.MustCount(q)
+}
+
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *StoreFixtureStore) Aggregate(q *StoreFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *StoreFixtureStore) AggregateContext(ctx context.Context, q *StoreFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *StoreFixtureStore) AggregateInto(q *StoreFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *StoreFixtureStore) AggregateIntoContext(ctx context.Context, q *StoreFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// FindOne returns the first row returned by the given query.
+// `ErrNotFound` is returned if there are no results.
+func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *StoreFixtureStore) FindOneContext(ctx context.Context, q *StoreFixtureQuery) (*StoreFixture, error) {
+	q.Limit(1)
+	q.Offset(0)
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// FindAll returns a list of all the rows returned by the given query.
+func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *StoreFixtureStore) FindAllContext(ctx context.Context, q *StoreFixtureQuery) ([]*StoreFixture, error) {
+	rs, err := s.FindContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// MustFindOne returns the first row retrieved by the given query. It panics
+// if there is an error or if there are no rows.
+func (s *StoreFixtureStore) MustFindOne(q *StoreFixtureQuery) *StoreFixture {
+	record, err := s.FindOne(q)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// Reload refreshes the StoreFixture with the data in the database and
+// makes it writable.
+func (s *StoreFixtureStore) Reload(record *StoreFixture) error {
+	return s.Store.Reload(Schema.StoreFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the StoreFixture with the data in the database using
+// the given context and makes it writable.
+func (s *StoreFixtureStore) ReloadContext(ctx context.Context, record *StoreFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreFixture.BaseSchema, record)
+}
+
+// Transaction executes the given callback in a transaction and rollbacks if
+// an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreFixtureStore) Transaction(callback func(*StoreFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreFixtureStore) TransactionContext(ctx context.Context, callback func(*StoreFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&StoreFixtureStore{store})
+	})
+}
+
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*StoreFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreFixtureStore{store})
+	})
+}
+
+// StoreFixtureQuery is the object used to create queries for the StoreFixture
+// entity.
+type StoreFixtureQuery struct {
+	*kallax.BaseQuery
+}
+
+// NewStoreFixtureQuery returns a new instance of StoreFixtureQuery.
+func NewStoreFixtureQuery() *StoreFixtureQuery {
+	return &StoreFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreFixture.BaseSchema),
+	}
+}
+
+// Select adds columns to select in the query.
+func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQuery {
+	if len(columns) == 0 {
+		return q
+	}
+	q.BaseQuery.Select(columns...)
+	return q
+}
+
+// SelectNot excludes columns from being selected in the query.
+func (q *StoreFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreFixtureQuery {
+	q.BaseQuery.SelectNot(columns...)
+	return q
+}
+
+// Copy returns a new identical copy of the query. Remember queries are mutable
+// so make a copy any time you need to reuse them.
+func (q *StoreFixtureQuery) Copy() *StoreFixtureQuery {
+	return &StoreFixtureQuery{
+		BaseQuery: q.BaseQuery.Copy(),
+	}
+}
+
+// Order adds order clauses to the query for the given columns.
+func (q *StoreFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreFixtureQuery {
+	q.BaseQuery.Order(cols...)
+	return q
+}
+
+// BatchSize sets the number of items to fetch per batch when there are 1:N
+// relationships selected in the query.
+func (q *StoreFixtureQuery) BatchSize(size uint64) *StoreFixtureQuery {
+	q.BaseQuery.BatchSize(size)
+	return q
+}
+
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *StoreFixtureQuery) UseCursor() *StoreFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
+// Limit sets the max number of items to retrieve.
+func (q *StoreFixtureQuery) Limit(n uint64) *StoreFixtureQuery {
+	q.BaseQuery.Limit(n)
+	return q
+}
+
+// Offset sets the number of items to skip from the result set of items.
+func (q *StoreFixtureQuery) Offset(n uint64) *StoreFixtureQuery {
+	q.BaseQuery.Offset(n)
+	return q
+}
+
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreFixtureQuery) After(cursor kallax.PageCursor) *StoreFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreFixtureQuery) Before(cursor kallax.PageCursor) *StoreFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
+// Where adds a condition to the query. All conditions added are concatenated
+// using a logical AND.
+func (q *StoreFixtureQuery) Where(cond kallax.Condition) *StoreFixtureQuery {
+	q.BaseQuery.Where(cond)
+	return q
+}
+
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *StoreFixtureQuery) GroupBy(fields ...kallax.SchemaField) *StoreFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *StoreFixtureQuery) Having(cond kallax.Condition) *StoreFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *StoreFixtureQuery) ForUpdate() *StoreFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *StoreFixtureQuery) ForShare() *StoreFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *StoreFixtureQuery) SkipLocked() *StoreFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *StoreFixtureQuery) NoWait() *StoreFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *StoreFixtureQuery) Of(schemas ...kallax.Schema) *StoreFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
+// it will do nothing.
+func (q *StoreFixtureQuery) FindByID(v ...kallax.ULID) *StoreFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.In(Schema.StoreFixture.ID, values...))
+}
+
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *StoreFixtureQuery) FindByFoo(v string) *StoreFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreFixture.Foo, v))
+}
+
+// FindBySliceProp adds a new filter to the query that will require that
+// the SliceProp property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *StoreFixtureQuery) FindBySliceProp(v ...string) *StoreFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.StoreFixture.SliceProp, values...))
+}
+
+// FindByAliasSliceProp adds a new filter to the query that will require that
+// the AliasSliceProp property contains all the passed values; if no passed values,
+// it will do nothing.
+func (q *StoreFixtureQuery) FindByAliasSliceProp(v ...string) *StoreFixtureQuery {
+	if len(v) == 0 {
+		return q
+	}
+	values := make([]interface{}, len(v))
+	for i, val := range v {
+		values[i] = val
+	}
+	return q.Where(kallax.ArrayContains(Schema.StoreFixture.AliasSliceProp, values...))
+}
+
+// StoreFixtureResultSet is the set of results returned by a query to the
+// database.
+type StoreFixtureResultSet struct {
+	ResultSet kallax.ResultSet
+	last      *StoreFixture
+	lastErr   error
+}
+
+// NewStoreFixtureResultSet creates a new result set for rows of the type
+// StoreFixture.
+func NewStoreFixtureResultSet(rs kallax.ResultSet) *StoreFixtureResultSet {
+	return &StoreFixtureResultSet{ResultSet: rs}
+}
+
+// Next fetches the next item in the result set and returns true if there is
+// a next item.
+// The result set is closed automatically when there are no more items.
+func (rs *StoreFixtureResultSet) Next() bool {
+	if !rs.ResultSet.Next() {
+		rs.lastErr = rs.ResultSet.Close()
+		rs.last = nil
+		return false
+	}
+
+	var record kallax.Record
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreFixture.BaseSchema)
+	if rs.lastErr != nil {
+		rs.last = nil
+	} else {
+		var ok bool
+		rs.last, ok = record.(*StoreFixture)
+		if !ok {
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreFixture")
+			rs.last = nil
+		}
+	}
+
+	return true
+}
+
+// Get retrieves the last fetched item from the result set and the last error.
+func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
+	return rs.last, rs.lastErr
+}
+
+// ForEach iterates over the complete result set passing every record found to
+// the given callback. It is possible to stop the iteration by returning
+// `kallax.ErrStop` in the callback.
+// Result set is always closed at the end.
+func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			if err == kallax.ErrStop {
+				return rs.Close()
+			}
+
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns all records on the result set and closes the result set.
+func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
+	var result []*StoreFixture
+	for rs.Next() {
+		record, err := rs.Get()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// One returns the first record on the result set and closes the result set.
+func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
+	if !rs.Next() {
+		return nil, kallax.ErrNotFound
+	}
+
+	record, err := rs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.Close(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Err returns the last error occurred.
+func (rs *StoreFixtureResultSet) Err() error {
+	return rs.lastErr
+}
+
+// Cursor returns the page cursor of the last StoreFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *StoreFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
+// Close closes the result set.
+func (rs *StoreFixtureResultSet) Close() error {
+	return rs.ResultSet.Close()
+}
+
+// NewStoreWithConstructFixture returns a new instance of StoreWithConstructFixture.
+func NewStoreWithConstructFixture(f string) (record *StoreWithConstructFixture) {
+	return newStoreWithConstructFixture(f)
+}
+
+// GetID returns the primary key of the model.
+func (r *StoreWithConstructFixture) GetID() kallax.Identifier {
+	return (*kallax.ULID)(&r.ID)
+}
+
+// ColumnAddress returns the pointer to the value of the given column.
+func (r *StoreWithConstructFixture) ColumnAddress(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return (*kallax.ULID)(&r.ID), nil
+	case "foo":
+		return &r.Foo, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+	}
+}
+
+// Value returns the value of the given column.
+func (r *StoreWithConstructFixture) Value(col string) (interface{}, error) {
+	switch col {
+	case "id":
+		return r.ID, nil
+	case "foo":
+		return r.Foo, nil
+
+	default:
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+	}
+}
+
+// NewRelationshipRecord returns a new record for the relatiobship in the given
+// field.
+func (r *StoreWithConstructFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+}
+
+// SetRelationship sets the given relationship in the given field.
+func (r *StoreWithConstructFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+}
+
+// StoreWithConstructFixtureStore is the entity to access the records of the type StoreWithConstructFixture
+// in the database.
+type StoreWithConstructFixtureStore struct {
 	*kallax.Store
 }
 
-// NewStoreFixtureStore creates a new instance of StoreFixtureStore
+// NewStoreWithConstructFixtureStore creates a new instance of StoreWithConstructFixtureStore
 // using a SQL database.
-func NewStoreFixtureStore(db *sql.DB) *StoreFixtureStore {
-	return &StoreFixtureStore{kallax.NewStore(db)}
+func NewStoreWithConstructFixtureStore(db *sql.DB) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{kallax.NewStore(db)}
+}
+
+// NewStoreWithConstructFixtureStoreWithDialect creates a new instance of StoreWithConstructFixtureStore
+// using a SQL database of the given dialect.
+func NewStoreWithConstructFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *StoreFixtureStore) GenericStore() *kallax.Store {
+func (s *StoreWithConstructFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *StoreFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *StoreWithConstructFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *StoreFixtureStore) Debug() *StoreFixtureStore {
-	return &StoreFixtureStore{s.Store.Debug()}
+func (s *StoreWithConstructFixtureStore) Debug() *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *StoreFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreFixtureStore {
-	return &StoreFixtureStore{s.Store.DebugWith(logger)}
+func (s *StoreWithConstructFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.DebugWith(logger)}
 }
 
-// Insert inserts a StoreFixture in the database. A non-persisted object is
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *StoreWithConstructFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *StoreWithConstructFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *StoreWithConstructFixtureStore) WithTracer(tracer kallax.Tracer) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *StoreWithConstructFixtureStore) WithMetrics(sink kallax.MetricsSink) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *StoreWithConstructFixtureStore) WithRetry(policy kallax.RetryPolicy) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{s.Store.WithRetry(policy)}
+}
+
+// Insert inserts a StoreWithConstructFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
+func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a StoreWithConstructFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *StoreWithConstructFixtureStore) InsertContext(ctx context.Context, record *StoreWithConstructFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given StoreWithConstructFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *StoreWithConstructFixtureStore) InsertMany(records []*StoreWithConstructFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given StoreWithConstructFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *StoreWithConstructFixtureStore) InsertManyContext(ctx context.Context, records []*StoreWithConstructFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, kallaxRecords...)
+
+}
 
-	return s.Store.Insert(Schema.StoreFixture.BaseSchema, record)
+// CopyFrom inserts the StoreWithConstructFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *StoreWithConstructFixtureStore) CopyFrom(records <-chan *StoreWithConstructFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
+
+// CopyFromContext inserts the StoreWithConstructFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *StoreWithConstructFixtureStore) CopyFromContext(ctx context.Context, records <-chan *StoreWithConstructFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *StoreWithConstructFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -7696,20 +15786,33 @@ func (s *StoreFixtureStore) Insert(record *StoreFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreFixtureStore) Update(record *StoreFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *StoreWithConstructFixtureStore) Update(record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-	return s.Store.Update(Schema.StoreFixture.BaseSchema, record, cols...)
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *StoreWithConstructFixtureStore) UpdateContext(ctx context.Context, record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error) {
+func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *StoreWithConstructFixtureStore) SaveContext(ctx context.Context, record *StoreWithConstructFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -7717,47 +15820,154 @@ func (s *StoreFixtureStore) Save(record *StoreFixture) (updated bool, err error)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *StoreWithConstructFixtureStore) Upsert(record *StoreWithConstructFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *StoreWithConstructFixtureStore) UpsertContext(ctx context.Context, record *StoreWithConstructFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
-func (s *StoreFixtureStore) Delete(record *StoreFixture) error {
+func (s *StoreWithConstructFixtureStore) Delete(record *StoreWithConstructFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *StoreWithConstructFixtureStore) DeleteContext(ctx context.Context, record *StoreWithConstructFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
 
-	return s.Store.Delete(Schema.StoreFixture.BaseSchema, record)
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *StoreWithConstructFixtureStore) UpdateAll(q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *StoreWithConstructFixtureStore) UpdateAllContext(ctx context.Context, q *StoreWithConstructFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *StoreWithConstructFixtureStore) DeleteAll(q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *StoreWithConstructFixtureStore) DeleteAllContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreFixtureStore) Find(q *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *StoreWithConstructFixtureStore) Find(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *StoreWithConstructFixtureStore) FindContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreFixtureResultSet(rs), nil
+	return NewStoreWithConstructFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreFixtureStore) MustFind(q *StoreFixtureQuery) *StoreFixtureResultSet {
-	return NewStoreFixtureResultSet(s.Store.MustFind(q))
+func (s *StoreWithConstructFixtureStore) MustFind(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
+	return NewStoreWithConstructFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as StoreWithConstructFixture records.
+func (s *StoreWithConstructFixtureStore) FindInto(q *StoreWithConstructFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *StoreWithConstructFixtureStore) FindIntoContext(ctx context.Context, q *StoreWithConstructFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreFixtureStore) Count(q *StoreFixtureQuery) (int64, error) {
+func (s *StoreWithConstructFixtureStore) Count(q *StoreWithConstructFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *StoreWithConstructFixtureStore) CountContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreFixtureStore) MustCount(q *StoreFixtureQuery) int64 {
+func (s *StoreWithConstructFixtureStore) MustCount(q *StoreWithConstructFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *StoreWithConstructFixtureStore) Aggregate(q *StoreWithConstructFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *StoreWithConstructFixtureStore) AggregateContext(ctx context.Context, q *StoreWithConstructFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *StoreWithConstructFixtureStore) AggregateInto(q *StoreWithConstructFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *StoreWithConstructFixtureStore) AggregateIntoContext(ctx context.Context, q *StoreWithConstructFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error) {
+func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *StoreWithConstructFixtureStore) FindOneContext(ctx context.Context, q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7779,8 +15989,14 @@ func (s *StoreFixtureStore) FindOne(q *StoreFixtureQuery) (*StoreFixture, error)
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, error) {
-	rs, err := s.Find(q)
+func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *StoreWithConstructFixtureStore) FindAllContext(ctx context.Context, q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -7790,7 +16006,7 @@ func (s *StoreFixtureStore) FindAll(q *StoreFixtureQuery) ([]*StoreFixture, erro
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreFixtureStore) MustFindOne(q *StoreFixtureQuery) *StoreFixture {
+func (s *StoreWithConstructFixtureStore) MustFindOne(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
 	record, err := s.FindOne(q)
 	if err != nil {
 		panic(err)
@@ -7798,41 +16014,77 @@ func (s *StoreFixtureStore) MustFindOne(q *StoreFixtureQuery) *StoreFixture {
 	return record
 }
 
-// Reload refreshes the StoreFixture with the data in the database and
+// Reload refreshes the StoreWithConstructFixture with the data in the database and
 // makes it writable.
-func (s *StoreFixtureStore) Reload(record *StoreFixture) error {
-	return s.Store.Reload(Schema.StoreFixture.BaseSchema, record)
+func (s *StoreWithConstructFixtureStore) Reload(record *StoreWithConstructFixture) error {
+	return s.Store.Reload(Schema.StoreWithConstructFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the StoreWithConstructFixture with the data in the database using
+// the given context and makes it writable.
+func (s *StoreWithConstructFixtureStore) ReloadContext(ctx context.Context, record *StoreWithConstructFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreWithConstructFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreFixtureStore) Transaction(callback func(*StoreFixtureStore) error) error {
+func (s *StoreWithConstructFixtureStore) Transaction(callback func(*StoreWithConstructFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithConstructFixtureStore) TransactionContext(ctx context.Context, callback func(*StoreWithConstructFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreFixtureStore{store})
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&StoreWithConstructFixtureStore{store})
 	})
 }
 
-// StoreFixtureQuery is the object used to create queries for the StoreFixture
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithConstructFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*StoreWithConstructFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithConstructFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreWithConstructFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreWithConstructFixtureStore{store})
+	})
+}
+
+// StoreWithConstructFixtureQuery is the object used to create queries for the StoreWithConstructFixture
 // entity.
-type StoreFixtureQuery struct {
+type StoreWithConstructFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreFixtureQuery returns a new instance of StoreFixtureQuery.
-func NewStoreFixtureQuery() *StoreFixtureQuery {
-	return &StoreFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreFixture.BaseSchema),
+// NewStoreWithConstructFixtureQuery returns a new instance of StoreWithConstructFixtureQuery.
+func NewStoreWithConstructFixtureQuery() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithConstructFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -7841,89 +16093,125 @@ func (q *StoreFixtureQuery) Select(columns ...kallax.SchemaField) *StoreFixtureQ
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreFixtureQuery) Copy() *StoreFixtureQuery {
-	return &StoreFixtureQuery{
+func (q *StoreWithConstructFixtureQuery) Copy() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *StoreFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *StoreFixtureQuery) BatchSize(size uint64) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) BatchSize(size uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *StoreWithConstructFixtureQuery) UseCursor() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
-func (q *StoreFixtureQuery) Limit(n uint64) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Limit(n uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *StoreFixtureQuery) Offset(n uint64) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Offset(n uint64) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreWithConstructFixtureQuery) After(cursor kallax.PageCursor) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreWithConstructFixtureQuery) Before(cursor kallax.PageCursor) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *StoreFixtureQuery) Where(cond kallax.Condition) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) Where(cond kallax.Condition) *StoreWithConstructFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
-// FindByID adds a new filter to the query that will require that
-// the ID property is equal to one of the passed values; if no passed values,
-// it will do nothing.
-func (q *StoreFixtureQuery) FindByID(v ...kallax.ULID) *StoreFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.In(Schema.StoreFixture.ID, values...))
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *StoreWithConstructFixtureQuery) GroupBy(fields ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
 }
 
-// FindByFoo adds a new filter to the query that will require that
-// the Foo property is equal to the passed value.
-func (q *StoreFixtureQuery) FindByFoo(v string) *StoreFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreFixture.Foo, v))
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *StoreWithConstructFixtureQuery) Having(cond kallax.Condition) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
 }
 
-// FindBySliceProp adds a new filter to the query that will require that
-// the SliceProp property contains all the passed values; if no passed values,
-// it will do nothing.
-func (q *StoreFixtureQuery) FindBySliceProp(v ...string) *StoreFixtureQuery {
-	if len(v) == 0 {
-		return q
-	}
-	values := make([]interface{}, len(v))
-	for i, val := range v {
-		values[i] = val
-	}
-	return q.Where(kallax.ArrayContains(Schema.StoreFixture.SliceProp, values...))
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *StoreWithConstructFixtureQuery) ForUpdate() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
 }
 
-// FindByAliasSliceProp adds a new filter to the query that will require that
-// the AliasSliceProp property contains all the passed values; if no passed values,
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *StoreWithConstructFixtureQuery) ForShare() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *StoreWithConstructFixtureQuery) SkipLocked() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *StoreWithConstructFixtureQuery) NoWait() *StoreWithConstructFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *StoreWithConstructFixtureQuery) Of(schemas ...kallax.Schema) *StoreWithConstructFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
+// FindByID adds a new filter to the query that will require that
+// the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreFixtureQuery) FindByAliasSliceProp(v ...string) *StoreFixtureQuery {
+func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithConstructFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -7931,27 +16219,33 @@ func (q *StoreFixtureQuery) FindByAliasSliceProp(v ...string) *StoreFixtureQuery
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.ArrayContains(Schema.StoreFixture.AliasSliceProp, values...))
+	return q.Where(kallax.In(Schema.StoreWithConstructFixture.ID, values...))
 }
 
-// StoreFixtureResultSet is the set of results returned by a query to the
+// FindByFoo adds a new filter to the query that will require that
+// the Foo property is equal to the passed value.
+func (q *StoreWithConstructFixtureQuery) FindByFoo(v string) *StoreWithConstructFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, v))
+}
+
+// StoreWithConstructFixtureResultSet is the set of results returned by a query to the
 // database.
-type StoreFixtureResultSet struct {
+type StoreWithConstructFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreFixture
+	last      *StoreWithConstructFixture
 	lastErr   error
 }
 
-// NewStoreFixtureResultSet creates a new result set for rows of the type
-// StoreFixture.
-func NewStoreFixtureResultSet(rs kallax.ResultSet) *StoreFixtureResultSet {
-	return &StoreFixtureResultSet{ResultSet: rs}
+// NewStoreWithConstructFixtureResultSet creates a new result set for rows of the type
+// StoreWithConstructFixture.
+func NewStoreWithConstructFixtureResultSet(rs kallax.ResultSet) *StoreWithConstructFixtureResultSet {
+	return &StoreWithConstructFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreFixtureResultSet) Next() bool {
+func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -7959,14 +16253,14 @@ func (rs *StoreFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithConstructFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreFixture)
+		rs.last, ok = record.(*StoreWithConstructFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithConstructFixture")
 			rs.last = nil
 		}
 	}
@@ -7975,7 +16269,7 @@ func (rs *StoreFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
+func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -7983,7 +16277,7 @@ func (rs *StoreFixtureResultSet) Get() (*StoreFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
+func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstructFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8002,8 +16296,8 @@ func (rs *StoreFixtureResultSet) ForEach(fn func(*StoreFixture) error) error {
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
-	var result []*StoreFixture
+func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
+	var result []*StoreWithConstructFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8015,7 +16309,7 @@ func (rs *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
+func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -8033,102 +16327,208 @@ func (rs *StoreFixtureResultSet) One() (*StoreFixture, error) {
 }
 
 // Err returns the last error occurred.
-func (rs *StoreFixtureResultSet) Err() error {
+func (rs *StoreWithConstructFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last StoreWithConstructFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *StoreWithConstructFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
-func (rs *StoreFixtureResultSet) Close() error {
+func (rs *StoreWithConstructFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreWithConstructFixture returns a new instance of StoreWithConstructFixture.
-func NewStoreWithConstructFixture(f string) (record *StoreWithConstructFixture) {
-	return newStoreWithConstructFixture(f)
+// NewStoreWithNewFixture returns a new instance of StoreWithNewFixture.
+func NewStoreWithNewFixture() (record *StoreWithNewFixture) {
+	return newStoreWithNewFixture()
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreWithConstructFixture) GetID() kallax.Identifier {
+func (r *StoreWithNewFixture) GetID() kallax.Identifier {
 	return (*kallax.ULID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreWithConstructFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *StoreWithNewFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return (*kallax.ULID)(&r.ID), nil
 	case "foo":
 		return &r.Foo, nil
+	case "bar":
+		return &r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreWithConstructFixture) Value(col string) (interface{}, error) {
+func (r *StoreWithNewFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
 	case "foo":
 		return r.Foo, nil
+	case "bar":
+		return r.Bar, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithConstructFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreWithConstructFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+func (r *StoreWithNewFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreWithConstructFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreWithConstructFixture has no relationships")
+func (r *StoreWithNewFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
 }
 
-// StoreWithConstructFixtureStore is the entity to access the records of the type StoreWithConstructFixture
+// StoreWithNewFixtureStore is the entity to access the records of the type StoreWithNewFixture
 // in the database.
-type StoreWithConstructFixtureStore struct {
+type StoreWithNewFixtureStore struct {
 	*kallax.Store
 }
 
-// NewStoreWithConstructFixtureStore creates a new instance of StoreWithConstructFixtureStore
-// using a SQL database.
-func NewStoreWithConstructFixtureStore(db *sql.DB) *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{kallax.NewStore(db)}
+// NewStoreWithNewFixtureStore creates a new instance of StoreWithNewFixtureStore
+// using a SQL database.
+func NewStoreWithNewFixtureStore(db *sql.DB) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{kallax.NewStore(db)}
+}
+
+// NewStoreWithNewFixtureStoreWithDialect creates a new instance of StoreWithNewFixtureStore
+// using a SQL database of the given dialect.
+func NewStoreWithNewFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
+}
+
+// GenericStore returns the generic store of this store.
+func (s *StoreWithNewFixtureStore) GenericStore() *kallax.Store {
+	return s.Store
+}
+
+// SetGenericStore changes the generic store of this store.
+func (s *StoreWithNewFixtureStore) SetGenericStore(store *kallax.Store) {
+	s.Store = store
+}
+
+// Debug returns a new store that will print all SQL statements to stdout using
+// the log.Printf function.
+func (s *StoreWithNewFixtureStore) Debug() *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.Debug()}
+}
+
+// DebugWith returns a new store that will print all SQL statements using the
+// given logger function.
+func (s *StoreWithNewFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.DebugWith(logger)}
+}
+
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *StoreWithNewFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *StoreWithNewFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *StoreWithNewFixtureStore) WithTracer(tracer kallax.Tracer) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *StoreWithNewFixtureStore) WithMetrics(sink kallax.MetricsSink) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *StoreWithNewFixtureStore) WithRetry(policy kallax.RetryPolicy) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{s.Store.WithRetry(policy)}
 }
 
-// GenericStore returns the generic store of this store.
-func (s *StoreWithConstructFixtureStore) GenericStore() *kallax.Store {
-	return s.Store
+// Insert inserts a StoreWithNewFixture in the database. A non-persisted object is
+// required for this operation.
+func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
+	return s.InsertContext(context.Background(), record)
 }
 
-// SetGenericStore changes the generic store of this store.
-func (s *StoreWithConstructFixtureStore) SetGenericStore(store *kallax.Store) {
-	s.Store = store
+// InsertContext inserts a StoreWithNewFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *StoreWithNewFixtureStore) InsertContext(ctx context.Context, record *StoreWithNewFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
+
 }
 
-// Debug returns a new store that will print all SQL statements to stdout using
-// the log.Printf function.
-func (s *StoreWithConstructFixtureStore) Debug() *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{s.Store.Debug()}
+// InsertMany inserts the given StoreWithNewFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *StoreWithNewFixtureStore) InsertMany(records []*StoreWithNewFixture) error {
+	return s.InsertManyContext(context.Background(), records)
 }
 
-// DebugWith returns a new store that will print all SQL statements using the
-// given logger function.
-func (s *StoreWithConstructFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{s.Store.DebugWith(logger)}
+// InsertManyContext inserts the given StoreWithNewFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *StoreWithNewFixtureStore) InsertManyContext(ctx context.Context, records []*StoreWithNewFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.StoreWithNewFixture.BaseSchema, kallaxRecords...)
+
 }
 
-// Insert inserts a StoreWithConstructFixture in the database. A non-persisted object is
-// required for this operation.
-func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixture) error {
+// CopyFrom inserts the StoreWithNewFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *StoreWithNewFixtureStore) CopyFrom(records <-chan *StoreWithNewFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
 
-	return s.Store.Insert(Schema.StoreWithConstructFixture.BaseSchema, record)
+// CopyFromContext inserts the StoreWithNewFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *StoreWithNewFixtureStore) CopyFromContext(ctx context.Context, records <-chan *StoreWithNewFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.StoreWithNewFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *StoreWithNewFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -8137,20 +16537,33 @@ func (s *StoreWithConstructFixtureStore) Insert(record *StoreWithConstructFixtur
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreWithConstructFixtureStore) Update(record *StoreWithConstructFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *StoreWithNewFixtureStore) Update(record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
+
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *StoreWithNewFixtureStore) UpdateContext(ctx context.Context, record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
 
-	return s.Store.Update(Schema.StoreWithConstructFixture.BaseSchema, record, cols...)
+	return s.Store.UpdateContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture) (updated bool, err error) {
+func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *StoreWithNewFixtureStore) SaveContext(ctx context.Context, record *StoreWithNewFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -8158,47 +16571,154 @@ func (s *StoreWithConstructFixtureStore) Save(record *StoreWithConstructFixture)
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *StoreWithNewFixtureStore) Upsert(record *StoreWithNewFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *StoreWithNewFixtureStore) UpsertContext(ctx context.Context, record *StoreWithNewFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
-func (s *StoreWithConstructFixtureStore) Delete(record *StoreWithConstructFixture) error {
+func (s *StoreWithNewFixtureStore) Delete(record *StoreWithNewFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *StoreWithNewFixtureStore) DeleteContext(ctx context.Context, record *StoreWithNewFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
+
+}
+
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *StoreWithNewFixtureStore) UpdateAll(q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *StoreWithNewFixtureStore) UpdateAllContext(ctx context.Context, q *StoreWithNewFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
 
-	return s.Store.Delete(Schema.StoreWithConstructFixture.BaseSchema, record)
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *StoreWithNewFixtureStore) DeleteAll(q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *StoreWithNewFixtureStore) DeleteAllContext(ctx context.Context, q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreWithConstructFixtureStore) Find(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *StoreWithNewFixtureStore) Find(q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *StoreWithNewFixtureStore) FindContext(ctx context.Context, q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreWithConstructFixtureResultSet(rs), nil
+	return NewStoreWithNewFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreWithConstructFixtureStore) MustFind(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
-	return NewStoreWithConstructFixtureResultSet(s.Store.MustFind(q))
+func (s *StoreWithNewFixtureStore) MustFind(q *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
+	return NewStoreWithNewFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as StoreWithNewFixture records.
+func (s *StoreWithNewFixtureStore) FindInto(q *StoreWithNewFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *StoreWithNewFixtureStore) FindIntoContext(ctx context.Context, q *StoreWithNewFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreWithConstructFixtureStore) Count(q *StoreWithConstructFixtureQuery) (int64, error) {
+func (s *StoreWithNewFixtureStore) Count(q *StoreWithNewFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *StoreWithNewFixtureStore) CountContext(ctx context.Context, q *StoreWithNewFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreWithConstructFixtureStore) MustCount(q *StoreWithConstructFixtureQuery) int64 {
+func (s *StoreWithNewFixtureStore) MustCount(q *StoreWithNewFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *StoreWithNewFixtureStore) Aggregate(q *StoreWithNewFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *StoreWithNewFixtureStore) AggregateContext(ctx context.Context, q *StoreWithNewFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *StoreWithNewFixtureStore) AggregateInto(q *StoreWithNewFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *StoreWithNewFixtureStore) AggregateIntoContext(ctx context.Context, q *StoreWithNewFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *StoreWithNewFixtureStore) FindOneContext(ctx context.Context, q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8220,8 +16740,14 @@ func (s *StoreWithConstructFixtureStore) FindOne(q *StoreWithConstructFixtureQue
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQuery) ([]*StoreWithConstructFixture, error) {
-	rs, err := s.Find(q)
+func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *StoreWithNewFixtureStore) FindAllContext(ctx context.Context, q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8231,7 +16757,7 @@ func (s *StoreWithConstructFixtureStore) FindAll(q *StoreWithConstructFixtureQue
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreWithConstructFixtureStore) MustFindOne(q *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
+func (s *StoreWithNewFixtureStore) MustFindOne(q *StoreWithNewFixtureQuery) *StoreWithNewFixture {
 	record, err := s.FindOne(q)
 	if err != nil {
 		panic(err)
@@ -8239,41 +16765,77 @@ func (s *StoreWithConstructFixtureStore) MustFindOne(q *StoreWithConstructFixtur
 	return record
 }
 
-// Reload refreshes the StoreWithConstructFixture with the data in the database and
+// Reload refreshes the StoreWithNewFixture with the data in the database and
 // makes it writable.
-func (s *StoreWithConstructFixtureStore) Reload(record *StoreWithConstructFixture) error {
-	return s.Store.Reload(Schema.StoreWithConstructFixture.BaseSchema, record)
+func (s *StoreWithNewFixtureStore) Reload(record *StoreWithNewFixture) error {
+	return s.Store.Reload(Schema.StoreWithNewFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the StoreWithNewFixture with the data in the database using
+// the given context and makes it writable.
+func (s *StoreWithNewFixtureStore) ReloadContext(ctx context.Context, record *StoreWithNewFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.StoreWithNewFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreWithConstructFixtureStore) Transaction(callback func(*StoreWithConstructFixtureStore) error) error {
+func (s *StoreWithNewFixtureStore) Transaction(callback func(*StoreWithNewFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithNewFixtureStore) TransactionContext(ctx context.Context, callback func(*StoreWithNewFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreWithConstructFixtureStore{store})
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&StoreWithNewFixtureStore{store})
 	})
 }
 
-// StoreWithConstructFixtureQuery is the object used to create queries for the StoreWithConstructFixture
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithNewFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*StoreWithNewFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *StoreWithNewFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*StoreWithNewFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&StoreWithNewFixtureStore{store})
+	})
+}
+
+// StoreWithNewFixtureQuery is the object used to create queries for the StoreWithNewFixture
 // entity.
-type StoreWithConstructFixtureQuery struct {
+type StoreWithNewFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreWithConstructFixtureQuery returns a new instance of StoreWithConstructFixtureQuery.
-func NewStoreWithConstructFixtureQuery() *StoreWithConstructFixtureQuery {
-	return &StoreWithConstructFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithConstructFixture.BaseSchema),
+// NewStoreWithNewFixtureQuery returns a new instance of StoreWithNewFixtureQuery.
+func NewStoreWithNewFixtureQuery() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithNewFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -8282,55 +16844,125 @@ func (q *StoreWithConstructFixtureQuery) Select(columns ...kallax.SchemaField) *
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreWithConstructFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreWithConstructFixtureQuery) Copy() *StoreWithConstructFixtureQuery {
-	return &StoreWithConstructFixtureQuery{
+func (q *StoreWithNewFixtureQuery) Copy() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *StoreWithConstructFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *StoreWithConstructFixtureQuery) BatchSize(size uint64) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) BatchSize(size uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *StoreWithNewFixtureQuery) UseCursor() *StoreWithNewFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
-func (q *StoreWithConstructFixtureQuery) Limit(n uint64) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Limit(n uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *StoreWithConstructFixtureQuery) Offset(n uint64) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Offset(n uint64) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreWithNewFixtureQuery) After(cursor kallax.PageCursor) *StoreWithNewFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *StoreWithNewFixtureQuery) Before(cursor kallax.PageCursor) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *StoreWithConstructFixtureQuery) Where(cond kallax.Condition) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) Where(cond kallax.Condition) *StoreWithNewFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *StoreWithNewFixtureQuery) GroupBy(fields ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *StoreWithNewFixtureQuery) Having(cond kallax.Condition) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *StoreWithNewFixtureQuery) ForUpdate() *StoreWithNewFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *StoreWithNewFixtureQuery) ForShare() *StoreWithNewFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *StoreWithNewFixtureQuery) SkipLocked() *StoreWithNewFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *StoreWithNewFixtureQuery) NoWait() *StoreWithNewFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *StoreWithNewFixtureQuery) Of(schemas ...kallax.Schema) *StoreWithNewFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithConstructFixtureQuery {
+func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -8338,33 +16970,39 @@ func (q *StoreWithConstructFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithCo
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.StoreWithConstructFixture.ID, values...))
+	return q.Where(kallax.In(Schema.StoreWithNewFixture.ID, values...))
 }
 
 // FindByFoo adds a new filter to the query that will require that
 // the Foo property is equal to the passed value.
-func (q *StoreWithConstructFixtureQuery) FindByFoo(v string) *StoreWithConstructFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithConstructFixture.Foo, v))
+func (q *StoreWithNewFixtureQuery) FindByFoo(v string) *StoreWithNewFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Foo, v))
 }
 
-// StoreWithConstructFixtureResultSet is the set of results returned by a query to the
+// FindByBar adds a new filter to the query that will require that
+// the Bar property is equal to the passed value.
+func (q *StoreWithNewFixtureQuery) FindByBar(v string) *StoreWithNewFixtureQuery {
+	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Bar, v))
+}
+
+// StoreWithNewFixtureResultSet is the set of results returned by a query to the
 // database.
-type StoreWithConstructFixtureResultSet struct {
+type StoreWithNewFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreWithConstructFixture
+	last      *StoreWithNewFixture
 	lastErr   error
 }
 
-// NewStoreWithConstructFixtureResultSet creates a new result set for rows of the type
-// StoreWithConstructFixture.
-func NewStoreWithConstructFixtureResultSet(rs kallax.ResultSet) *StoreWithConstructFixtureResultSet {
-	return &StoreWithConstructFixtureResultSet{ResultSet: rs}
+// NewStoreWithNewFixtureResultSet creates a new result set for rows of the type
+// StoreWithNewFixture.
+func NewStoreWithNewFixtureResultSet(rs kallax.ResultSet) *StoreWithNewFixtureResultSet {
+	return &StoreWithNewFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreWithConstructFixtureResultSet) Next() bool {
+func (rs *StoreWithNewFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -8372,14 +17010,14 @@ func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithConstructFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithNewFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreWithConstructFixture)
+		rs.last, ok = record.(*StoreWithNewFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithConstructFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithNewFixture")
 			rs.last = nil
 		}
 	}
@@ -8388,7 +17026,7 @@ func (rs *StoreWithConstructFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
+func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -8396,7 +17034,7 @@ func (rs *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture,
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstructFixture) error) error {
+func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8415,8 +17053,8 @@ func (rs *StoreWithConstructFixtureResultSet) ForEach(fn func(*StoreWithConstruc
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
-	var result []*StoreWithConstructFixture
+func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
+	var result []*StoreWithNewFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8428,7 +17066,7 @@ func (rs *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixtur
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
+func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -8446,106 +17084,208 @@ func (rs *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture,
 }
 
 // Err returns the last error occurred.
-func (rs *StoreWithConstructFixtureResultSet) Err() error {
+func (rs *StoreWithNewFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last StoreWithNewFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *StoreWithNewFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
-func (rs *StoreWithConstructFixtureResultSet) Close() error {
+func (rs *StoreWithNewFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
-// NewStoreWithNewFixture returns a new instance of StoreWithNewFixture.
-func NewStoreWithNewFixture() (record *StoreWithNewFixture) {
-	return newStoreWithNewFixture()
+// NewVersionedFixture returns a new instance of VersionedFixture.
+func NewVersionedFixture() (record *VersionedFixture) {
+	return new(VersionedFixture)
 }
 
 // GetID returns the primary key of the model.
-func (r *StoreWithNewFixture) GetID() kallax.Identifier {
-	return (*kallax.ULID)(&r.ID)
+func (r *VersionedFixture) GetID() kallax.Identifier {
+	return (*kallax.NumericID)(&r.ID)
 }
 
 // ColumnAddress returns the pointer to the value of the given column.
-func (r *StoreWithNewFixture) ColumnAddress(col string) (interface{}, error) {
+func (r *VersionedFixture) ColumnAddress(col string) (interface{}, error) {
 	switch col {
 	case "id":
-		return (*kallax.ULID)(&r.ID), nil
+		return (*kallax.NumericID)(&r.ID), nil
 	case "foo":
-		return &r.Foo, nil
-	case "bar":
-		return &r.Bar, nil
+		return &r.Foo, nil
+	case "version":
+		return &r.Version, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in VersionedFixture: %s", col)
 	}
 }
 
 // Value returns the value of the given column.
-func (r *StoreWithNewFixture) Value(col string) (interface{}, error) {
+func (r *VersionedFixture) Value(col string) (interface{}, error) {
 	switch col {
 	case "id":
 		return r.ID, nil
 	case "foo":
 		return r.Foo, nil
-	case "bar":
-		return r.Bar, nil
+	case "version":
+		return r.Version, nil
 
 	default:
-		return nil, fmt.Errorf("kallax: invalid column in StoreWithNewFixture: %s", col)
+		return nil, fmt.Errorf("kallax: invalid column in VersionedFixture: %s", col)
 	}
 }
 
 // NewRelationshipRecord returns a new record for the relatiobship in the given
 // field.
-func (r *StoreWithNewFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
-	return nil, fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
+func (r *VersionedFixture) NewRelationshipRecord(field string) (kallax.Record, error) {
+	return nil, fmt.Errorf("kallax: model VersionedFixture has no relationships")
 }
 
 // SetRelationship sets the given relationship in the given field.
-func (r *StoreWithNewFixture) SetRelationship(field string, rel interface{}) error {
-	return fmt.Errorf("kallax: model StoreWithNewFixture has no relationships")
+func (r *VersionedFixture) SetRelationship(field string, rel interface{}) error {
+	return fmt.Errorf("kallax: model VersionedFixture has no relationships")
 }
 
-// StoreWithNewFixtureStore is the entity to access the records of the type StoreWithNewFixture
+// VersionedFixtureStore is the entity to access the records of the type VersionedFixture
 // in the database.
-type StoreWithNewFixtureStore struct {
+type VersionedFixtureStore struct {
 	*kallax.Store
 }
 
-// NewStoreWithNewFixtureStore creates a new instance of StoreWithNewFixtureStore
+// NewVersionedFixtureStore creates a new instance of VersionedFixtureStore
 // using a SQL database.
-func NewStoreWithNewFixtureStore(db *sql.DB) *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{kallax.NewStore(db)}
+func NewVersionedFixtureStore(db *sql.DB) *VersionedFixtureStore {
+	return &VersionedFixtureStore{kallax.NewStore(db)}
+}
+
+// NewVersionedFixtureStoreWithDialect creates a new instance of VersionedFixtureStore
+// using a SQL database of the given dialect.
+func NewVersionedFixtureStoreWithDialect(db *sql.DB, dialect kallax.Dialect) *VersionedFixtureStore {
+	return &VersionedFixtureStore{kallax.NewStoreWithDialect(db, dialect)}
 }
 
 // GenericStore returns the generic store of this store.
-func (s *StoreWithNewFixtureStore) GenericStore() *kallax.Store {
+func (s *VersionedFixtureStore) GenericStore() *kallax.Store {
 	return s.Store
 }
 
 // SetGenericStore changes the generic store of this store.
-func (s *StoreWithNewFixtureStore) SetGenericStore(store *kallax.Store) {
+func (s *VersionedFixtureStore) SetGenericStore(store *kallax.Store) {
 	s.Store = store
 }
 
 // Debug returns a new store that will print all SQL statements to stdout using
 // the log.Printf function.
-func (s *StoreWithNewFixtureStore) Debug() *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{s.Store.Debug()}
+func (s *VersionedFixtureStore) Debug() *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.Debug()}
 }
 
 // DebugWith returns a new store that will print all SQL statements using the
 // given logger function.
-func (s *StoreWithNewFixtureStore) DebugWith(logger kallax.LoggerFunc) *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{s.Store.DebugWith(logger)}
+func (s *VersionedFixtureStore) DebugWith(logger kallax.LoggerFunc) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.DebugWith(logger)}
 }
 
-// Insert inserts a StoreWithNewFixture in the database. A non-persisted object is
+// WithInterceptors returns a new store that will run all its SQL statements
+// through the given interceptors.
+func (s *VersionedFixtureStore) WithInterceptors(interceptors ...kallax.Interceptor) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithInterceptors(interceptors...)}
+}
+
+// WithQueryLogger returns a new store that will log all its SQL statements
+// with the given logger and options.
+func (s *VersionedFixtureStore) WithQueryLogger(logger kallax.QueryLogger, opts kallax.QueryLogOptions) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithQueryLogger(logger, opts)}
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *VersionedFixtureStore) WithTracer(tracer kallax.Tracer) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithTracer(tracer)}
+}
+
+// WithMetrics returns a new store that will report its metrics to the given
+// sink.
+func (s *VersionedFixtureStore) WithMetrics(sink kallax.MetricsSink) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithMetrics(sink)}
+}
+
+// WithRetry returns a new store that will retry its transactions according
+// to the given policy.
+func (s *VersionedFixtureStore) WithRetry(policy kallax.RetryPolicy) *VersionedFixtureStore {
+	return &VersionedFixtureStore{s.Store.WithRetry(policy)}
+}
+
+// Insert inserts a VersionedFixture in the database. A non-persisted object is
 // required for this operation.
-func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
+func (s *VersionedFixtureStore) Insert(record *VersionedFixture) error {
+	return s.InsertContext(context.Background(), record)
+}
+
+// InsertContext inserts a VersionedFixture in the database using the given context.
+// A non-persisted object is required for this operation.
+func (s *VersionedFixtureStore) InsertContext(ctx context.Context, record *VersionedFixture) error {
+
+	return s.Store.InsertContext(ctx, Schema.VersionedFixture.BaseSchema, record)
+
+}
+
+// InsertMany inserts the given VersionedFixture records in the database using as
+// few statements as possible. Only non-persisted objects can be inserted.
+func (s *VersionedFixtureStore) InsertMany(records []*VersionedFixture) error {
+	return s.InsertManyContext(context.Background(), records)
+}
+
+// InsertManyContext inserts the given VersionedFixture records in the database using
+// the given context. Only non-persisted objects can be inserted.
+func (s *VersionedFixtureStore) InsertManyContext(ctx context.Context, records []*VersionedFixture) error {
+
+	var kallaxRecords = make([]kallax.Record, len(records))
+	for i, record := range records {
+
+		kallaxRecords[i] = record
+	}
+
+	return s.Store.InsertManyContext(ctx, Schema.VersionedFixture.BaseSchema, kallaxRecords...)
+
+}
+
+// CopyFrom inserts the VersionedFixture records received from the given channel in
+// the database using COPY FROM, until the channel is closed. Only
+// non-persisted objects can be copied, and their relationships are not
+// copied. Returns the number of records copied.
+func (s *VersionedFixtureStore) CopyFrom(records <-chan *VersionedFixture) (int64, error) {
+	return s.CopyFromContext(context.Background(), records)
+}
 
-	return s.Store.Insert(Schema.StoreWithNewFixture.BaseSchema, record)
+// CopyFromContext inserts the VersionedFixture records received from the given
+// channel in the database using COPY FROM with the given context, until the
+// channel is closed or the context is done.
+func (s *VersionedFixtureStore) CopyFromContext(ctx context.Context, records <-chan *VersionedFixture) (int64, error) {
+	return s.Store.CopyFromContext(ctx, Schema.VersionedFixture.BaseSchema, kallax.RecordIteratorFunc(func() (kallax.Record, error) {
+		var record *VersionedFixture
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r, ok := <-records:
+			if !ok {
+				return nil, io.EOF
+			}
+			record = r
+		}
 
+		return record, nil
+	}))
 }
 
 // Update updates the given record on the database. If the columns are given,
@@ -8554,20 +17294,33 @@ func (s *StoreWithNewFixtureStore) Insert(record *StoreWithNewFixture) error {
 // in memory but not on the database.
 // Only writable records can be updated. Writable objects are those that have
 // been just inserted or retrieved using a query with no custom select fields.
-func (s *StoreWithNewFixtureStore) Update(record *StoreWithNewFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+func (s *VersionedFixtureStore) Update(record *VersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+	return s.UpdateContext(context.Background(), record, cols...)
+}
 
-	return s.Store.Update(Schema.StoreWithNewFixture.BaseSchema, record, cols...)
+// UpdateContext updates the given record on the database using the given
+// context. Same rules of Update apply.
+func (s *VersionedFixtureStore) UpdateContext(ctx context.Context, record *VersionedFixture, cols ...kallax.SchemaField) (updated int64, err error) {
+
+	return s.Store.UpdateContext(ctx, Schema.VersionedFixture.BaseSchema, record, cols...)
 
 }
 
 // Save inserts the object if the record is not persisted, otherwise it updates
 // it. Same rules of Update and Insert apply depending on the case.
-func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bool, err error) {
+func (s *VersionedFixtureStore) Save(record *VersionedFixture) (updated bool, err error) {
+	return s.SaveContext(context.Background(), record)
+}
+
+// SaveContext inserts the object if the record is not persisted, otherwise it
+// updates it, using the given context. Same rules of Update and Insert apply
+// depending on the case.
+func (s *VersionedFixtureStore) SaveContext(ctx context.Context, record *VersionedFixture) (updated bool, err error) {
 	if !record.IsPersisted() {
-		return false, s.Insert(record)
+		return false, s.InsertContext(ctx, record)
 	}
 
-	rowsUpdated, err := s.Update(record)
+	rowsUpdated, err := s.UpdateContext(ctx, record)
 	if err != nil {
 		return false, err
 	}
@@ -8575,47 +17328,186 @@ func (s *StoreWithNewFixtureStore) Save(record *StoreWithNewFixture) (updated bo
 	return rowsUpdated > 0, nil
 }
 
+// Upsert inserts the given record or, if it conflicts with an existing row
+// on the given conflict columns, updates the given columns of that row. If no
+// update columns are given, the existing row is left untouched.
+// A non-persisted object is required for this operation. Relationships of
+// the record are not saved. Only the BeforeSave and AfterSave events are
+// triggered, as it is not known whether the row is inserted or updated, so
+// the insert and update events are not.
+func (s *VersionedFixtureStore) Upsert(record *VersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+	return s.UpsertContext(context.Background(), record, conflictCols, updateCols...)
+}
+
+// UpsertContext inserts or updates the given record using the given context.
+// Same rules of Upsert apply.
+func (s *VersionedFixtureStore) UpsertContext(ctx context.Context, record *VersionedFixture, conflictCols []kallax.SchemaField, updateCols ...kallax.SchemaField) error {
+
+	return s.Store.UpsertContext(ctx, Schema.VersionedFixture.BaseSchema, record, conflictCols, updateCols...)
+
+}
+
 // Delete removes the given record from the database.
-func (s *StoreWithNewFixtureStore) Delete(record *StoreWithNewFixture) error {
+func (s *VersionedFixtureStore) Delete(record *VersionedFixture) error {
+	return s.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext removes the given record from the database using the given
+// context.
+func (s *VersionedFixtureStore) DeleteContext(ctx context.Context, record *VersionedFixture) error {
+
+	return s.Store.DeleteContext(ctx, Schema.VersionedFixture.BaseSchema, record)
+
+}
 
-	return s.Store.Delete(Schema.StoreWithNewFixture.BaseSchema, record)
+// UpdateAll updates the given columns with the given values in all the rows
+// matching the given query and returns the number of updated rows.
+// No events are triggered and no relationships are updated.
+func (s *VersionedFixtureStore) UpdateAll(q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAll(q, values)
+}
+
+// UpdateAllContext updates the given columns with the given values in all the
+// rows matching the given query using the given context.
+func (s *VersionedFixtureStore) UpdateAllContext(ctx context.Context, q *VersionedFixtureQuery, values map[kallax.SchemaField]interface{}) (int64, error) {
+	return s.Store.UpdateAllContext(ctx, q, values)
+}
+
+// DeleteAll removes all the rows matching the given query and returns the
+// number of removed rows.
+// No events are triggered and no relationships are removed.
+func (s *VersionedFixtureStore) DeleteAll(q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteAll(q)
+}
 
+// DeleteAllContext removes all the rows matching the given query using the
+// given context.
+func (s *VersionedFixtureStore) DeleteAllContext(ctx context.Context, q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.DeleteAllContext(ctx, q)
 }
 
 // Find returns the set of results for the given query.
-func (s *StoreWithNewFixtureStore) Find(q *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
-	rs, err := s.Store.Find(q)
+func (s *VersionedFixtureStore) Find(q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	return s.FindContext(context.Background(), q)
+}
+
+// FindContext returns the set of results for the given query using the given
+// context.
+func (s *VersionedFixtureStore) FindContext(ctx context.Context, q *VersionedFixtureQuery) (*VersionedFixtureResultSet, error) {
+	rs, err := s.Store.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStoreWithNewFixtureResultSet(rs), nil
+	return NewVersionedFixtureResultSet(rs), nil
 }
 
 // MustFind returns the set of results for the given query, but panics if there
 // is any error.
-func (s *StoreWithNewFixtureStore) MustFind(q *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
-	return NewStoreWithNewFixtureResultSet(s.Store.MustFind(q))
+func (s *VersionedFixtureStore) MustFind(q *VersionedFixtureQuery) *VersionedFixtureResultSet {
+	return NewVersionedFixtureResultSet(s.Store.MustFind(q))
+}
+
+// FindInto stores the rows selected by the given query in the given pointer
+// to a slice of structs, instead of retrieving them as VersionedFixture records.
+func (s *VersionedFixtureStore) FindInto(q *VersionedFixtureQuery, dest interface{}) error {
+	return s.Store.FindInto(q, dest)
+}
+
+// FindIntoContext stores the rows selected by the given query in the given
+// pointer to a slice of structs using the given context.
+func (s *VersionedFixtureStore) FindIntoContext(ctx context.Context, q *VersionedFixtureQuery, dest interface{}) error {
+	return s.Store.FindIntoContext(ctx, q, dest)
 }
 
 // Count returns the number of rows that would be retrieved with the given
 // query.
-func (s *StoreWithNewFixtureStore) Count(q *StoreWithNewFixtureQuery) (int64, error) {
+func (s *VersionedFixtureStore) Count(q *VersionedFixtureQuery) (int64, error) {
 	return s.Store.Count(q)
 }
 
+// CountContext returns the number of rows that would be retrieved with the
+// given query using the given context.
+func (s *VersionedFixtureStore) CountContext(ctx context.Context, q *VersionedFixtureQuery) (int64, error) {
+	return s.Store.CountContext(ctx, q)
+}
+
 // MustCount returns the number of rows that would be retrieved with the given
 // query, but panics if there is an error.
-func (s *StoreWithNewFixtureStore) MustCount(q *StoreWithNewFixtureQuery) int64 {
+func (s *VersionedFixtureStore) MustCount(q *VersionedFixtureQuery) int64 {
 	return s.Store.MustCount(q)
 }
 
+// Aggregate returns the given aggregates over the rows selected by the given
+// query, with a row per group if the query is grouped.
+func (s *VersionedFixtureStore) Aggregate(q *VersionedFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.Aggregate(q, aggregates...)
+}
+
+// AggregateContext returns the given aggregates over the rows selected by the
+// given query using the given context.
+func (s *VersionedFixtureStore) AggregateContext(ctx context.Context, q *VersionedFixtureQuery, aggregates ...*kallax.Aggregate) ([]kallax.AggregateRow, error) {
+	return s.Store.AggregateContext(ctx, q, aggregates...)
+}
+
+// AggregateInto stores the given aggregates over the rows selected by the
+// given query in the given pointer to a slice of structs.
+func (s *VersionedFixtureStore) AggregateInto(q *VersionedFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateInto(q, dest, aggregates...)
+}
+
+// AggregateIntoContext stores the given aggregates over the rows selected by
+// the given query in the given pointer to a slice of structs using the given
+// context.
+func (s *VersionedFixtureStore) AggregateIntoContext(ctx context.Context, q *VersionedFixtureQuery, dest interface{}, aggregates ...*kallax.Aggregate) error {
+	return s.Store.AggregateIntoContext(ctx, q, dest, aggregates...)
+}
+
+// SumVersion returns the sum of the Version property of the items
+// selected by the given query, or zero if there are none.
+func (s *VersionedFixtureStore) SumVersion(q *VersionedFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Sum(Schema.VersionedFixture.Version), &v)
+	return v, err
+}
+
+// AvgVersion returns the average of the Version property of the items
+// selected by the given query, or zero if there are none.
+func (s *VersionedFixtureStore) AvgVersion(q *VersionedFixtureQuery) (float64, error) {
+	var v float64
+	err := s.Store.AggregateValue(q, kallax.Avg(Schema.VersionedFixture.Version), &v)
+	return v, err
+}
+
+// MinVersion returns the minimum value of the Version property of the
+// items selected by the given query, or zero if there are none.
+func (s *VersionedFixtureStore) MinVersion(q *VersionedFixtureQuery) (int64, error) {
+	var v int64
+	err := s.Store.AggregateValue(q, kallax.Min(Schema.VersionedFixture.Version), &v)
+	return v, err
+}
+
+// MaxVersion returns the maximum value of the Version property of the
+// items selected by the given query, or zero if there are none.
+func (s *VersionedFixtureStore) MaxVersion(q *VersionedFixtureQuery) (int64, error) {
+	var v int64
+	err := s.Store.AggregateValue(q, kallax.Max(Schema.VersionedFixture.Version), &v)
+	return v, err
+}
+
 // FindOne returns the first row returned by the given query.
 // `ErrNotFound` is returned if there are no results.
-func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+func (s *VersionedFixtureStore) FindOne(q *VersionedFixtureQuery) (*VersionedFixture, error) {
+	return s.FindOneContext(context.Background(), q)
+}
+
+// FindOneContext returns the first row returned by the given query using the
+// given context.
+// `ErrNotFound` is returned if there are no results.
+func (s *VersionedFixtureStore) FindOneContext(ctx context.Context, q *VersionedFixtureQuery) (*VersionedFixture, error) {
 	q.Limit(1)
 	q.Offset(0)
-	rs, err := s.Find(q)
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8637,8 +17529,14 @@ func (s *StoreWithNewFixtureStore) FindOne(q *StoreWithNewFixtureQuery) (*StoreW
 }
 
 // FindAll returns a list of all the rows returned by the given query.
-func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*StoreWithNewFixture, error) {
-	rs, err := s.Find(q)
+func (s *VersionedFixtureStore) FindAll(q *VersionedFixtureQuery) ([]*VersionedFixture, error) {
+	return s.FindAllContext(context.Background(), q)
+}
+
+// FindAllContext returns a list of all the rows returned by the given query
+// using the given context.
+func (s *VersionedFixtureStore) FindAllContext(ctx context.Context, q *VersionedFixtureQuery) ([]*VersionedFixture, error) {
+	rs, err := s.FindContext(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -8648,7 +17546,7 @@ func (s *StoreWithNewFixtureStore) FindAll(q *StoreWithNewFixtureQuery) ([]*Stor
 
 // MustFindOne returns the first row retrieved by the given query. It panics
 // if there is an error or if there are no rows.
-func (s *StoreWithNewFixtureStore) MustFindOne(q *StoreWithNewFixtureQuery) *StoreWithNewFixture {
+func (s *VersionedFixtureStore) MustFindOne(q *VersionedFixtureQuery) *VersionedFixture {
 	record, err := s.FindOne(q)
 	if err != nil {
 		panic(err)
@@ -8656,41 +17554,77 @@ func (s *StoreWithNewFixtureStore) MustFindOne(q *StoreWithNewFixtureQuery) *Sto
 	return record
 }
 
-// Reload refreshes the StoreWithNewFixture with the data in the database and
+// Reload refreshes the VersionedFixture with the data in the database and
 // makes it writable.
-func (s *StoreWithNewFixtureStore) Reload(record *StoreWithNewFixture) error {
-	return s.Store.Reload(Schema.StoreWithNewFixture.BaseSchema, record)
+func (s *VersionedFixtureStore) Reload(record *VersionedFixture) error {
+	return s.Store.Reload(Schema.VersionedFixture.BaseSchema, record)
+}
+
+// ReloadContext refreshes the VersionedFixture with the data in the database using
+// the given context and makes it writable.
+func (s *VersionedFixtureStore) ReloadContext(ctx context.Context, record *VersionedFixture) error {
+	return s.Store.ReloadContext(ctx, Schema.VersionedFixture.BaseSchema, record)
 }
 
 // Transaction executes the given callback in a transaction and rollbacks if
 // an error is returned.
 // The transaction is only open in the store passed as a parameter to the
 // callback.
-func (s *StoreWithNewFixtureStore) Transaction(callback func(*StoreWithNewFixtureStore) error) error {
+func (s *VersionedFixtureStore) Transaction(callback func(*VersionedFixtureStore) error) error {
+	return s.TransactionContext(context.Background(), callback)
+}
+
+// TransactionContext executes the given callback in a transaction started
+// with the given context and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *VersionedFixtureStore) TransactionContext(ctx context.Context, callback func(*VersionedFixtureStore) error) error {
 	if callback == nil {
 		return kallax.ErrInvalidTxCallback
 	}
 
-	return s.Store.Transaction(func(store *kallax.Store) error {
-		return callback(&StoreWithNewFixtureStore{store})
+	return s.Store.TransactionContext(ctx, func(store *kallax.Store) error {
+		return callback(&VersionedFixtureStore{store})
 	})
 }
 
-// StoreWithNewFixtureQuery is the object used to create queries for the StoreWithNewFixture
+// TransactionWith executes the given callback in a transaction started with
+// the given options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *VersionedFixtureStore) TransactionWith(opts *sql.TxOptions, callback func(*VersionedFixtureStore) error) error {
+	return s.TransactionWithContext(context.Background(), opts, callback)
+}
+
+// TransactionWithContext executes the given callback in a transaction started
+// with the given context and options and rollbacks if an error is returned.
+// The transaction is only open in the store passed as a parameter to the
+// callback.
+func (s *VersionedFixtureStore) TransactionWithContext(ctx context.Context, opts *sql.TxOptions, callback func(*VersionedFixtureStore) error) error {
+	if callback == nil {
+		return kallax.ErrInvalidTxCallback
+	}
+
+	return s.Store.TransactionWithContext(ctx, opts, func(store *kallax.Store) error {
+		return callback(&VersionedFixtureStore{store})
+	})
+}
+
+// VersionedFixtureQuery is the object used to create queries for the VersionedFixture
 // entity.
-type StoreWithNewFixtureQuery struct {
+type VersionedFixtureQuery struct {
 	*kallax.BaseQuery
 }
 
-// NewStoreWithNewFixtureQuery returns a new instance of StoreWithNewFixtureQuery.
-func NewStoreWithNewFixtureQuery() *StoreWithNewFixtureQuery {
-	return &StoreWithNewFixtureQuery{
-		BaseQuery: kallax.NewBaseQuery(Schema.StoreWithNewFixture.BaseSchema),
+// NewVersionedFixtureQuery returns a new instance of VersionedFixtureQuery.
+func NewVersionedFixtureQuery() *VersionedFixtureQuery {
+	return &VersionedFixtureQuery{
+		BaseQuery: kallax.NewBaseQuery(Schema.VersionedFixture.BaseSchema),
 	}
 }
 
 // Select adds columns to select in the query.
-func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Select(columns ...kallax.SchemaField) *VersionedFixtureQuery {
 	if len(columns) == 0 {
 		return q
 	}
@@ -8699,55 +17633,125 @@ func (q *StoreWithNewFixtureQuery) Select(columns ...kallax.SchemaField) *StoreW
 }
 
 // SelectNot excludes columns from being selected in the query.
-func (q *StoreWithNewFixtureQuery) SelectNot(columns ...kallax.SchemaField) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) SelectNot(columns ...kallax.SchemaField) *VersionedFixtureQuery {
 	q.BaseQuery.SelectNot(columns...)
 	return q
 }
 
 // Copy returns a new identical copy of the query. Remember queries are mutable
 // so make a copy any time you need to reuse them.
-func (q *StoreWithNewFixtureQuery) Copy() *StoreWithNewFixtureQuery {
-	return &StoreWithNewFixtureQuery{
+func (q *VersionedFixtureQuery) Copy() *VersionedFixtureQuery {
+	return &VersionedFixtureQuery{
 		BaseQuery: q.BaseQuery.Copy(),
 	}
 }
 
 // Order adds order clauses to the query for the given columns.
-func (q *StoreWithNewFixtureQuery) Order(cols ...kallax.ColumnOrder) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Order(cols ...kallax.ColumnOrder) *VersionedFixtureQuery {
 	q.BaseQuery.Order(cols...)
 	return q
 }
 
 // BatchSize sets the number of items to fetch per batch when there are 1:N
 // relationships selected in the query.
-func (q *StoreWithNewFixtureQuery) BatchSize(size uint64) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) BatchSize(size uint64) *VersionedFixtureQuery {
 	q.BaseQuery.BatchSize(size)
 	return q
 }
 
+// UseCursor makes the store stream the items from a server-side cursor,
+// fetching them in batches of the batch size of the query.
+func (q *VersionedFixtureQuery) UseCursor() *VersionedFixtureQuery {
+	q.BaseQuery.UseCursor()
+	return q
+}
+
 // Limit sets the max number of items to retrieve.
-func (q *StoreWithNewFixtureQuery) Limit(n uint64) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Limit(n uint64) *VersionedFixtureQuery {
 	q.BaseQuery.Limit(n)
 	return q
 }
 
 // Offset sets the number of items to skip from the result set of items.
-func (q *StoreWithNewFixtureQuery) Offset(n uint64) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Offset(n uint64) *VersionedFixtureQuery {
 	q.BaseQuery.Offset(n)
 	return q
 }
 
+// After makes the query retrieve only the items following the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *VersionedFixtureQuery) After(cursor kallax.PageCursor) *VersionedFixtureQuery {
+	q.BaseQuery.After(cursor)
+	return q
+}
+
+// Before makes the query retrieve only the items preceding the one of the
+// given page cursor, returned by a result set of a query with the same order.
+func (q *VersionedFixtureQuery) Before(cursor kallax.PageCursor) *VersionedFixtureQuery {
+	q.BaseQuery.Before(cursor)
+	return q
+}
+
 // Where adds a condition to the query. All conditions added are concatenated
 // using a logical AND.
-func (q *StoreWithNewFixtureQuery) Where(cond kallax.Condition) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) Where(cond kallax.Condition) *VersionedFixtureQuery {
 	q.BaseQuery.Where(cond)
 	return q
 }
 
+// GroupBy adds the given fields to the list of fields to group the items by
+// when the query is aggregated.
+func (q *VersionedFixtureQuery) GroupBy(fields ...kallax.SchemaField) *VersionedFixtureQuery {
+	q.BaseQuery.GroupBy(fields...)
+	return q
+}
+
+// Having adds a new condition to filter the groups of items when the query
+// is aggregated. All conditions added are concatenated using a logical AND.
+func (q *VersionedFixtureQuery) Having(cond kallax.Condition) *VersionedFixtureQuery {
+	q.BaseQuery.Having(cond)
+	return q
+}
+
+// ForUpdate makes the query lock the items it retrieves for update until
+// the transaction of the store ends.
+func (q *VersionedFixtureQuery) ForUpdate() *VersionedFixtureQuery {
+	q.BaseQuery.ForUpdate()
+	return q
+}
+
+// ForShare makes the query lock the items it retrieves for share until the
+// transaction of the store ends.
+func (q *VersionedFixtureQuery) ForShare() *VersionedFixtureQuery {
+	q.BaseQuery.ForShare()
+	return q
+}
+
+// SkipLocked makes the query skip the items locked by other transactions
+// instead of waiting for them.
+func (q *VersionedFixtureQuery) SkipLocked() *VersionedFixtureQuery {
+	q.BaseQuery.SkipLocked()
+	return q
+}
+
+// NoWait makes the query fail if any of the items it retrieves is locked by
+// another transaction instead of waiting for it.
+func (q *VersionedFixtureQuery) NoWait() *VersionedFixtureQuery {
+	q.BaseQuery.NoWait()
+	return q
+}
+
+// Of makes the query lock only the items of the given schemas, which must be
+// the one of the query or its 1:1 relationships.
+func (q *VersionedFixtureQuery) Of(schemas ...kallax.Schema) *VersionedFixtureQuery {
+	q.BaseQuery.Of(schemas...)
+	return q
+}
+
 // FindByID adds a new filter to the query that will require that
 // the ID property is equal to one of the passed values; if no passed values,
 // it will do nothing.
-func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtureQuery {
+func (q *VersionedFixtureQuery) FindByID(v ...int64) *VersionedFixtureQuery {
 	if len(v) == 0 {
 		return q
 	}
@@ -8755,39 +17759,39 @@ func (q *StoreWithNewFixtureQuery) FindByID(v ...kallax.ULID) *StoreWithNewFixtu
 	for i, val := range v {
 		values[i] = val
 	}
-	return q.Where(kallax.In(Schema.StoreWithNewFixture.ID, values...))
+	return q.Where(kallax.In(Schema.VersionedFixture.ID, values...))
 }
 
 // FindByFoo adds a new filter to the query that will require that
 // the Foo property is equal to the passed value.
-func (q *StoreWithNewFixtureQuery) FindByFoo(v string) *StoreWithNewFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Foo, v))
+func (q *VersionedFixtureQuery) FindByFoo(v string) *VersionedFixtureQuery {
+	return q.Where(kallax.Eq(Schema.VersionedFixture.Foo, v))
 }
 
-// FindByBar adds a new filter to the query that will require that
-// the Bar property is equal to the passed value.
-func (q *StoreWithNewFixtureQuery) FindByBar(v string) *StoreWithNewFixtureQuery {
-	return q.Where(kallax.Eq(Schema.StoreWithNewFixture.Bar, v))
+// FindByVersion adds a new filter to the query that will require that
+// the Version property is equal to the passed value.
+func (q *VersionedFixtureQuery) FindByVersion(cond kallax.ScalarCond, v int64) *VersionedFixtureQuery {
+	return q.Where(cond(Schema.VersionedFixture.Version, v))
 }
 
-// StoreWithNewFixtureResultSet is the set of results returned by a query to the
+// VersionedFixtureResultSet is the set of results returned by a query to the
 // database.
-type StoreWithNewFixtureResultSet struct {
+type VersionedFixtureResultSet struct {
 	ResultSet kallax.ResultSet
-	last      *StoreWithNewFixture
+	last      *VersionedFixture
 	lastErr   error
 }
 
-// NewStoreWithNewFixtureResultSet creates a new result set for rows of the type
-// StoreWithNewFixture.
-func NewStoreWithNewFixtureResultSet(rs kallax.ResultSet) *StoreWithNewFixtureResultSet {
-	return &StoreWithNewFixtureResultSet{ResultSet: rs}
+// NewVersionedFixtureResultSet creates a new result set for rows of the type
+// VersionedFixture.
+func NewVersionedFixtureResultSet(rs kallax.ResultSet) *VersionedFixtureResultSet {
+	return &VersionedFixtureResultSet{ResultSet: rs}
 }
 
 // Next fetches the next item in the result set and returns true if there is
 // a next item.
 // The result set is closed automatically when there are no more items.
-func (rs *StoreWithNewFixtureResultSet) Next() bool {
+func (rs *VersionedFixtureResultSet) Next() bool {
 	if !rs.ResultSet.Next() {
 		rs.lastErr = rs.ResultSet.Close()
 		rs.last = nil
@@ -8795,14 +17799,14 @@ func (rs *StoreWithNewFixtureResultSet) Next() bool {
 	}
 
 	var record kallax.Record
-	record, rs.lastErr = rs.ResultSet.Get(Schema.StoreWithNewFixture.BaseSchema)
+	record, rs.lastErr = rs.ResultSet.Get(Schema.VersionedFixture.BaseSchema)
 	if rs.lastErr != nil {
 		rs.last = nil
 	} else {
 		var ok bool
-		rs.last, ok = record.(*StoreWithNewFixture)
+		rs.last, ok = record.(*VersionedFixture)
 		if !ok {
-			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *StoreWithNewFixture")
+			rs.lastErr = fmt.Errorf("kallax: unable to convert record to *VersionedFixture")
 			rs.last = nil
 		}
 	}
@@ -8811,7 +17815,7 @@ func (rs *StoreWithNewFixtureResultSet) Next() bool {
 }
 
 // Get retrieves the last fetched item from the result set and the last error.
-func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
+func (rs *VersionedFixtureResultSet) Get() (*VersionedFixture, error) {
 	return rs.last, rs.lastErr
 }
 
@@ -8819,7 +17823,7 @@ func (rs *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
 // the given callback. It is possible to stop the iteration by returning
 // `kallax.ErrStop` in the callback.
 // Result set is always closed at the end.
-func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) error) error {
+func (rs *VersionedFixtureResultSet) ForEach(fn func(*VersionedFixture) error) error {
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8838,8 +17842,8 @@ func (rs *StoreWithNewFixtureResultSet) ForEach(fn func(*StoreWithNewFixture) er
 }
 
 // All returns all records on the result set and closes the result set.
-func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
-	var result []*StoreWithNewFixture
+func (rs *VersionedFixtureResultSet) All() ([]*VersionedFixture, error) {
+	var result []*VersionedFixture
 	for rs.Next() {
 		record, err := rs.Get()
 		if err != nil {
@@ -8851,7 +17855,7 @@ func (rs *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
 }
 
 // One returns the first record on the result set and closes the result set.
-func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
+func (rs *VersionedFixtureResultSet) One() (*VersionedFixture, error) {
 	if !rs.Next() {
 		return nil, kallax.ErrNotFound
 	}
@@ -8869,12 +17873,24 @@ func (rs *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
 }
 
 // Err returns the last error occurred.
-func (rs *StoreWithNewFixtureResultSet) Err() error {
+func (rs *VersionedFixtureResultSet) Err() error {
 	return rs.lastErr
 }
 
+// Cursor returns the page cursor of the last VersionedFixture retrieved, which can
+// be given to the After and Before methods of a query with the same order.
+// kallax.ErrPageCursorNotSupported is returned if the underlying result set
+// does not implement kallax.PageCursorResultSet.
+func (rs *VersionedFixtureResultSet) Cursor() (kallax.PageCursor, error) {
+	crs, ok := rs.ResultSet.(kallax.PageCursorResultSet)
+	if !ok {
+		return "", kallax.ErrPageCursorNotSupported
+	}
+	return crs.Cursor()
+}
+
 // Close closes the result set.
-func (rs *StoreWithNewFixtureResultSet) Close() error {
+func (rs *VersionedFixtureResultSet) Close() error {
 	return rs.ResultSet.Close()
 }
 
@@ -8893,9 +17909,12 @@ type schema struct {
 	ResultSetFixture          *schemaResultSetFixture
 	SchemaFixture             *schemaSchemaFixture
 	SchemaRelationshipFixture *schemaSchemaRelationshipFixture
+	SoftDeleteFixture         *schemaSoftDeleteFixture
+	SoftDeleteItemFixture     *schemaSoftDeleteItemFixture
 	StoreFixture              *schemaStoreFixture
 	StoreWithConstructFixture *schemaStoreWithConstructFixture
 	StoreWithNewFixture       *schemaStoreWithNewFixture
+	VersionedFixture          *schemaVersionedFixture
 }
 
 type schemaCar struct {
@@ -9032,6 +18051,20 @@ type schemaSchemaRelationshipFixture struct {
 	ID kallax.SchemaField
 }
 
+type schemaSoftDeleteFixture struct {
+	*kallax.BaseSchema
+	ID        kallax.SchemaField
+	DeletedAt kallax.SchemaField
+	Foo       kallax.SchemaField
+}
+
+type schemaSoftDeleteItemFixture struct {
+	*kallax.BaseSchema
+	ID        kallax.SchemaField
+	DeletedAt kallax.SchemaField
+	Name      kallax.SchemaField
+}
+
 type schemaStoreFixture struct {
 	*kallax.BaseSchema
 	ID             kallax.SchemaField
@@ -9053,6 +18086,13 @@ type schemaStoreWithNewFixture struct {
 	Bar kallax.SchemaField
 }
 
+type schemaVersionedFixture struct {
+	*kallax.BaseSchema
+	ID      kallax.SchemaField
+	Foo     kallax.SchemaField
+	Version kallax.SchemaField
+}
+
 type schemaJSONModelBar struct {
 	*kallax.BaseSchemaField
 	Qux *schemaJSONModelBarQux
@@ -9448,6 +18488,44 @@ var Schema = &schema{
 		),
 		ID: kallax.NewSchemaField("id"),
 	},
+	SoftDeleteFixture: &schemaSoftDeleteFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"soft_delete",
+			"__softdeletefixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{
+				"Items": kallax.NewForeignKey("owner_id", false),
+			},
+			func() kallax.Record {
+				return new(SoftDeleteFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("deleted_at"),
+			kallax.NewSchemaField("foo"),
+		).WithSoftDelete(kallax.NewSchemaField("deleted_at")),
+		ID:        kallax.NewSchemaField("id"),
+		DeletedAt: kallax.NewSchemaField("deleted_at"),
+		Foo:       kallax.NewSchemaField("foo"),
+	},
+	SoftDeleteItemFixture: &schemaSoftDeleteItemFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"soft_delete_item",
+			"__softdeleteitemfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(SoftDeleteItemFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("deleted_at"),
+			kallax.NewSchemaField("name"),
+		).WithSoftDelete(kallax.NewSchemaField("deleted_at")),
+		ID:        kallax.NewSchemaField("id"),
+		DeletedAt: kallax.NewSchemaField("deleted_at"),
+		Name:      kallax.NewSchemaField("name"),
+	},
 	StoreFixture: &schemaStoreFixture{
 		BaseSchema: kallax.NewBaseSchema(
 			"store",
@@ -9502,4 +18580,22 @@ var Schema = &schema{
 		Foo: kallax.NewSchemaField("foo"),
 		Bar: kallax.NewSchemaField("bar"),
 	},
+	VersionedFixture: &schemaVersionedFixture{
+		BaseSchema: kallax.NewBaseSchema(
+			"versioned",
+			"__versionedfixture",
+			kallax.NewSchemaField("id"),
+			kallax.ForeignKeys{},
+			func() kallax.Record {
+				return new(VersionedFixture)
+			},
+			true,
+			kallax.NewSchemaField("id"),
+			kallax.NewSchemaField("foo"),
+			kallax.NewSchemaField("version"),
+		).WithVersion(kallax.NewSchemaField("version")),
+		ID:      kallax.NewSchemaField("id"),
+		Foo:     kallax.NewSchemaField("foo"),
+		Version: kallax.NewSchemaField("version"),
+	},
 }
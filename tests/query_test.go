@@ -64,7 +64,7 @@ func (s *QuerySuite) SetupTest() {
 	s.BaseTestSuite.SetupTest()
 
 	resetQueryFixtures()
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	for _, fixture := range queryFixtures {
 		s.Nil(store.Insert(fixture))
 	}
@@ -77,7 +77,7 @@ func (s *QuerySuite) TestInsertTruncateTime() {
 		f.TimeParam = time.Now()
 	}
 
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(f))
 
 	f2, err := store.FindOne(NewQueryFixtureQuery().FindByID(f.ID))
@@ -88,7 +88,7 @@ func (s *QuerySuite) TestInsertTruncateTime() {
 func (s *QuerySuite) TestUpdateTruncateTime() {
 	s.BaseTestSuite.SetupTest()
 	f := NewQueryFixture("fixture")
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(f))
 	for f.TimeParam.Nanosecond() == 0 {
 		f.TimeParam = time.Now()
@@ -108,7 +108,7 @@ func (s *QuerySuite) TestSaveTruncateTime() {
 		f.TimeParam = time.Now()
 	}
 
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	_, err := store.Save(f)
 	s.NoError(err)
 
@@ -118,7 +118,7 @@ func (s *QuerySuite) TestSaveTruncateTime() {
 }
 
 func (s *QuerySuite) TestQuery() {
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	doc := NewQueryFixture("bar")
 	s.Nil(store.Insert(doc))
 
@@ -137,8 +137,147 @@ func (s *QuerySuite) TestQuery() {
 	})
 }
 
+func (s *QuerySuite) TestAggregates() {
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
+	for i, foo := range []string{"a", "a", "b"} {
+		doc := NewQueryFixture(foo)
+		doc.Integer = i + 1
+		s.NoError(store.Insert(doc))
+	}
+
+	sum, err := store.SumInteger(NewQueryFixtureQuery())
+	s.NoError(err)
+	s.Equal(6.0, sum)
+
+	avg, err := store.AvgInteger(NewQueryFixtureQuery().FindByFoo("a"))
+	s.NoError(err)
+	s.Equal(1.5, avg)
+
+	min, err := store.MinInteger(NewQueryFixtureQuery())
+	s.NoError(err)
+	s.Equal(1, min)
+
+	max, err := store.MaxInteger(NewQueryFixtureQuery().FindByFoo("c"))
+	s.NoError(err)
+	s.Equal(0, max)
+
+	var result []struct {
+		Foo        string
+		SumInteger int64
+	}
+	q := NewQueryFixtureQuery().
+		GroupBy(Schema.QueryFixture.Foo).
+		Having(kallax.Gt(kallax.Count(Schema.QueryFixture.Foo), 1)).
+		Order(kallax.Asc(Schema.QueryFixture.Foo))
+	s.NoError(store.AggregateInto(q, &result, kallax.Sum(Schema.QueryFixture.Integer)))
+	s.Require().Len(result, 1)
+	s.Equal("a", result[0].Foo)
+	s.Equal(int64(3), result[0].SumInteger)
+}
+
+func (s *QuerySuite) TestFindInto() {
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
+	for i, foo := range []string{"a", "b"} {
+		doc := NewQueryFixture(foo)
+		doc.Integer = i + 1
+		s.NoError(store.Insert(doc))
+	}
+
+	var result []struct {
+		ID      kallax.ULID
+		Foo     string
+		Integer int
+	}
+	q := NewQueryFixtureQuery().
+		Select(Schema.QueryFixture.ID, Schema.QueryFixture.Foo, Schema.QueryFixture.Integer).
+		Order(kallax.Desc(Schema.QueryFixture.Integer))
+	s.NoError(store.FindInto(q, &result))
+	s.Require().Len(result, 2)
+	s.Equal("b", result[0].Foo)
+	s.Equal(2, result[0].Integer)
+	s.Equal("a", result[1].Foo)
+	s.Equal(1, result[1].Integer)
+	s.False(result[0].ID.IsEmpty())
+}
+
+func (s *QuerySuite) TestFindForUpdate() {
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b"} {
+		s.NoError(store.Insert(NewQueryFixture(foo)))
+	}
+
+	newQuery := func() *QueryFixtureQuery {
+		return NewQueryFixtureQuery().
+			Order(kallax.Asc(Schema.QueryFixture.Foo)).
+			ForUpdate().
+			Of(Schema.QueryFixture.BaseSchema).
+			SkipLocked()
+	}
+
+	_, err := store.FindOne(newQuery())
+	s.Equal(kallax.ErrLockOutsideTransaction, err)
+
+	err = store.Transaction(func(tx1 *QueryFixtureStore) error {
+		doc, err := tx1.FindOne(newQuery())
+		s.Require().NoError(err)
+		s.Equal("a", doc.Foo)
+
+		return store.Transaction(func(tx2 *QueryFixtureStore) error {
+			doc, err := tx2.FindOne(newQuery())
+			s.Require().NoError(err)
+			s.Equal("b", doc.Foo)
+
+			_, err = tx2.FindOne(NewQueryFixtureQuery().FindByFoo("a").ForUpdate().NoWait())
+			return err
+		})
+	})
+	s.Error(err)
+}
+
+func (s *QuerySuite) TestFindWithSubqueries() {
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b", "c"} {
+		doc := NewQueryFixture(foo)
+		if foo != "c" {
+			doc.NRelation = []*QueryRelationFixture{
+				{ID: kallax.NewULID(), Name: "rel_" + foo},
+			}
+		}
+		s.NoError(store.Insert(doc))
+	}
+
+	foos := func(q *QueryFixtureQuery) []string {
+		docs, err := store.FindAll(q.Order(kallax.Asc(Schema.QueryFixture.Foo)))
+		s.Require().NoError(err)
+
+		var result []string
+		for _, doc := range docs {
+			result = append(result, doc.Foo)
+		}
+		return result
+	}
+
+	s.Equal([]string{"b"}, foos(NewQueryFixtureQuery().
+		WhereNRelationExist(NewQueryRelationFixtureQuery().FindByName("rel_b"))))
+	s.Equal([]string{"a", "b"}, foos(NewQueryFixtureQuery().WhereNRelationExist(nil)))
+
+	owners := NewQueryRelationFixtureQuery().Select(Schema.QueryRelationFixture.OwnerFK)
+	s.Equal([]string{"a", "b"}, foos(NewQueryFixtureQuery().
+		Where(kallax.InQuery(Schema.QueryFixture.ID, owners))))
+	s.Equal([]string{"c"}, foos(NewQueryFixtureQuery().
+		Where(kallax.NotInQuery(Schema.QueryFixture.ID, owners))))
+
+	related := NewQueryRelationFixtureQuery().Where(kallax.Correlated(
+		Schema.QueryRelationFixture.OwnerFK,
+		Schema.QueryFixture.BaseSchema,
+		Schema.QueryFixture.ID,
+	))
+	s.Equal([]string{"c"}, foos(NewQueryFixtureQuery().Where(kallax.NotExists(related))))
+	s.Equal([]string{"a", "b"}, foos(NewQueryFixtureQuery().Where(kallax.Exists(related))))
+}
+
 func (s *QuerySuite) TestFindById() {
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 
 	docName := "bar"
 	doc := NewQueryFixture(docName)
@@ -165,7 +304,7 @@ func (s *QuerySuite) TestFindById() {
 }
 
 func (s *QuerySuite) TestFindBy() {
-	store := NewQueryFixtureStore(s.db)
+	store := NewQueryFixtureStoreWithDialect(s.db, dialect)
 	s.NotPanics(func() {
 		s.True(store.MustFindOne(NewQueryFixtureQuery().FindByStringProperty("StringProperty1")).Eq(queryFixtures[1]))
 	})
@@ -37,7 +37,7 @@ func (s *RelationshipsSuite) TestInsertFind() {
 	cat := NewPet("Garfield", "cat", p)
 	dog := NewPet("Oddie", "dog", p)
 
-	store := NewPersonStore(s.db)
+	store := NewPersonStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(p))
 
 	pers := s.getPerson()
@@ -51,7 +51,7 @@ func (s *RelationshipsSuite) TestInsertFindRemove() {
 	dog := NewPet("Oddie", "dog", p)
 	reptar := NewPet("Reptar", "dinosaur", p)
 
-	store := NewPersonStore(s.db)
+	store := NewPersonStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(p))
 
 	pers := s.getPerson()
@@ -73,7 +73,7 @@ func (s *RelationshipsSuite) TestInsertFindUpdate() {
 	cat := NewPet("Garfield", "cat", p)
 	dog := NewPet("Oddie", "dog", p)
 
-	store := NewPersonStore(s.db)
+	store := NewPersonStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(p))
 
 	pers := s.getPerson()
@@ -94,7 +94,7 @@ func (s *RelationshipsSuite) TestEvents() {
 	dog := NewPet("Oddie", "dog", p)
 	reptar := NewPet("Reptar", "dinosaur", p)
 
-	store := NewPersonStore(s.db)
+	store := NewPersonStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(p))
 
 	s.assertEvents(p.events, "BeforeSave", "AfterSave")
@@ -120,7 +120,7 @@ func (s *RelationshipsSuite) TestSaveWithInverse() {
 	p := NewPerson("Foo")
 	car := NewCar("Bar", p)
 
-	store := NewCarStore(s.db)
+	store := NewCarStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(car))
 
 	s.NotNil(s.getPerson())
@@ -176,7 +176,7 @@ func (s *RelationshipsSuite) getPerson() *Person {
 	q := NewPersonQuery().
 		WithCar().
 		WithPets(nil)
-	pers, err := NewPersonStore(s.db).FindOne(q)
+	pers, err := NewPersonStoreWithDialect(s.db, dialect).FindOne(q)
 	s.NoError(err)
 	s.NotNil(pers)
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gopkg.in/src-d/go-kallax.v1"
 )
@@ -23,7 +24,7 @@ func TestResulsetSuite(t *testing.T) {
 }
 
 func (s *ResulsetSuite) TestResultSetAll() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 	s.Nil(store.Insert(NewResultSetFixture("foo")))
 
@@ -36,7 +37,7 @@ func (s *ResulsetSuite) TestResultSetAll() {
 }
 
 func (s *ResulsetSuite) TestResultSetOne() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 
 	s.NotPanics(func() {
@@ -48,7 +49,7 @@ func (s *ResulsetSuite) TestResultSetOne() {
 }
 
 func (s *ResulsetSuite) TestResultSetNextEmpty() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 
 	s.NotPanics(func() {
 		rs := store.MustFind(NewResultSetFixtureQuery())
@@ -62,7 +63,7 @@ func (s *ResulsetSuite) TestResultSetNextEmpty() {
 }
 
 func (s *ResulsetSuite) TestResultSetNext() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 
 	s.NotPanics(func() {
@@ -84,7 +85,7 @@ func (s *ResulsetSuite) TestResultSetNext() {
 }
 
 func (s *ResulsetSuite) TestResultSetForEach() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 	s.Nil(store.Insert(NewResultSetFixture("foo")))
 
@@ -102,7 +103,7 @@ func (s *ResulsetSuite) TestResultSetForEach() {
 }
 
 func (s *ResulsetSuite) TestResultSetForEachStop() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 	s.Nil(store.Insert(NewResultSetFixture("foo")))
 
@@ -120,7 +121,7 @@ func (s *ResulsetSuite) TestResultSetForEachStop() {
 }
 
 func (s *ResulsetSuite) TestResultSetForEachError() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewResultSetFixture("bar")))
 	s.Nil(store.Insert(NewResultSetFixture("foo")))
 
@@ -137,7 +138,7 @@ func (s *ResulsetSuite) TestResultSetForEachError() {
 }
 
 func (s *ResulsetSuite) TestForEachAndCount() {
-	store := NewResultSetFixtureStore(s.db)
+	store := NewResultSetFixtureStoreWithDialect(s.db, dialect)
 
 	docInserted1 := NewResultSetFixture("bar")
 	s.Nil(store.Insert(docInserted1))
@@ -159,3 +160,14 @@ func (s *ResulsetSuite) TestForEachAndCount() {
 	s.NoError(err)
 	s.Equal(int64(2), queriedCount)
 }
+
+// resultSetMock is a result set implementing only kallax.ResultSet.
+type resultSetMock struct {
+	kallax.ResultSet
+}
+
+func TestResultSetCursor_NotSupported(t *testing.T) {
+	rs := NewResultSetFixtureResultSet(resultSetMock{})
+	_, err := rs.Cursor()
+	require.Equal(t, kallax.ErrPageCursorNotSupported, err)
+}
@@ -67,3 +67,25 @@ type Nullable struct {
 	SomeJSON     *SomeJSON
 	Scanner      *kallax.ULID
 }
+
+type VersionedFixture struct {
+	kallax.Model `table:"versioned"`
+	ID           int64 `pk:"autoincr"`
+	Foo          string
+	Version      int64 `kallax:",version"`
+}
+
+type SoftDeleteFixture struct {
+	kallax.Model `table:"soft_delete"`
+	kallax.SoftDelete
+	ID    int64 `pk:"autoincr"`
+	Foo   string
+	Items []*SoftDeleteItemFixture `fk:"owner_id"`
+}
+
+type SoftDeleteItemFixture struct {
+	kallax.Model `table:"soft_delete_item"`
+	kallax.SoftDelete
+	ID   int64 `pk:"autoincr"`
+	Name string
+}
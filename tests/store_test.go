@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -37,8 +39,24 @@ func TestStoreSuite(t *testing.T) {
 			some_json jsonb,
 			scanner uuid
 		)`,
+		`CREATE TABLE IF NOT EXISTS versioned (
+			id serial primary key,
+			foo varchar(10),
+			version bigint not null default 0
+		)`,
+		`CREATE TABLE IF NOT EXISTS soft_delete (
+			id serial primary key,
+			foo varchar(10),
+			deleted_at timestamptz
+		)`,
+		`CREATE TABLE IF NOT EXISTS soft_delete_item (
+			id serial primary key,
+			name varchar(10),
+			owner_id integer references soft_delete(id),
+			deleted_at timestamptz
+		)`,
 	}
-	suite.Run(t, &StoreSuite{NewBaseSuite(schema, "store_construct", "store", "store_new", "query", "nullable")})
+	suite.Run(t, &StoreSuite{NewBaseSuite(schema, "store_construct", "store", "store_new", "query", "nullable", "versioned", "soft_delete_item", "soft_delete")})
 }
 
 type StoreSuite struct {
@@ -58,7 +76,7 @@ func (s *StoreSuite) TestStoreQuery() {
 }
 
 func (s *StoreSuite) TestStoreMustFind() {
-	store := NewStoreFixtureStore(s.db)
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewStoreFixture()))
 	s.Nil(store.Insert(NewStoreFixture()))
 
@@ -75,7 +93,7 @@ func (s *StoreSuite) TestStoreFailingOnNew() {
 }
 
 func (s *StoreSuite) TestStoreFindOneReturnValues() {
-	store := NewStoreWithConstructFixtureStore(s.db)
+	store := NewStoreWithConstructFixtureStoreWithDialect(s.db, dialect)
 	s.Nil(store.Insert(NewStoreWithConstructFixture("bar")))
 
 	notFoundQuery := NewStoreWithConstructFixtureQuery()
@@ -88,7 +106,7 @@ func (s *StoreSuite) TestStoreFindOneReturnValues() {
 }
 
 func (s *StoreSuite) TestStoreInsertUpdateMustFind() {
-	store := NewStoreWithConstructFixtureStore(s.db)
+	store := NewStoreWithConstructFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewStoreWithConstructFixture("foo")
 	err := store.Insert(doc)
@@ -107,7 +125,7 @@ func (s *StoreSuite) TestStoreInsertUpdateMustFind() {
 }
 
 func (s *StoreSuite) TestStoreSave() {
-	store := NewStoreWithConstructFixtureStore(s.db)
+	store := NewStoreWithConstructFixtureStoreWithDialect(s.db, dialect)
 
 	doc := NewStoreWithConstructFixture("foo")
 	updated, err := store.Save(doc)
@@ -128,7 +146,7 @@ func (s *StoreSuite) TestStoreSave() {
 }
 
 func (s *StoreSuite) TestMultiKeySort() {
-	store := NewMultiKeySortFixtureStore(s.db)
+	store := NewMultiKeySortFixtureStoreWithDialect(s.db, dialect)
 
 	var (
 		doc *MultiKeySortFixture
@@ -195,7 +213,7 @@ func (s *StoreSuite) TestMultiKeySort() {
 }
 
 func (s *StoreSuite) TestFindOne() {
-	store := NewStoreWithConstructFixtureStore(s.db)
+	store := NewStoreWithConstructFixtureStoreWithDialect(s.db, dialect)
 
 	docInserted := NewStoreWithConstructFixture("bar")
 	s.Nil(store.Insert(docInserted))
@@ -208,8 +226,157 @@ func (s *StoreSuite) TestFindOne() {
 	}
 }
 
+func (s *StoreSuite) TestFindOneContext() {
+	store := NewStoreWithConstructFixtureStoreWithDialect(s.db, dialect)
+	s.Nil(store.InsertContext(context.Background(), NewStoreWithConstructFixture("bar")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	docFound, err := store.FindOneContext(ctx, NewStoreWithConstructFixtureQuery())
+	s.resultOrError(docFound, err)
+
+	cancel()
+	_, err = store.FindOneContext(ctx, NewStoreWithConstructFixtureQuery())
+	s.Error(err)
+}
+
+func (s *StoreSuite) TestUpdateAllDeleteAll() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b", "c"} {
+		fixture := NewStoreFixture()
+		fixture.Foo = foo
+		s.Nil(store.Insert(fixture))
+	}
+
+	updated, err := store.UpdateAll(
+		NewStoreFixtureQuery().Where(kallax.In(Schema.StoreFixture.Foo, "a", "b")),
+		map[kallax.SchemaField]interface{}{Schema.StoreFixture.Foo: "d"},
+	)
+	s.NoError(err)
+	s.Equal(int64(2), updated)
+	s.Equal(int64(2), store.MustCount(NewStoreFixtureQuery().FindByFoo("d")))
+
+	deleted, err := store.DeleteAll(NewStoreFixtureQuery().FindByFoo("d"))
+	s.NoError(err)
+	s.Equal(int64(2), deleted)
+	s.Equal(int64(1), store.MustCount(NewStoreFixtureQuery()))
+}
+
+func (s *StoreSuite) TestUpdateVersioned() {
+	store := NewVersionedFixtureStoreWithDialect(s.db, dialect)
+	doc := NewVersionedFixture()
+	doc.Foo = "foo"
+	s.Nil(store.Insert(doc))
+	s.Equal(int64(0), doc.Version)
+
+	stale, err := store.FindOne(NewVersionedFixtureQuery().FindByID(doc.ID))
+	s.NoError(err)
+
+	doc.Foo = "bar"
+	_, err = store.Update(doc)
+	s.NoError(err)
+	s.Equal(int64(1), doc.Version)
+
+	stale.Foo = "baz"
+	_, err = store.Update(stale)
+	s.Equal(kallax.ErrStaleRecord, err)
+	s.Equal(int64(0), stale.Version)
+
+	found, err := store.FindOne(NewVersionedFixtureQuery().FindByID(doc.ID))
+	s.NoError(err)
+	s.Equal("bar", found.Foo)
+	s.Equal(int64(1), found.Version)
+
+	updated, err := store.UpdateAll(
+		NewVersionedFixtureQuery(),
+		map[kallax.SchemaField]interface{}{Schema.VersionedFixture.Foo: "qux"},
+	)
+	s.NoError(err)
+	s.Equal(int64(1), updated)
+
+	s.NoError(store.Reload(found))
+	s.Equal(int64(2), found.Version)
+}
+
+func (s *StoreSuite) TestSoftDelete() {
+	store := NewSoftDeleteFixtureStoreWithDialect(s.db, dialect)
+	doc := NewSoftDeleteFixture()
+	doc.Foo = "foo"
+	s.Nil(store.Insert(doc))
+	s.False(doc.IsDeleted())
+
+	s.NoError(store.Delete(doc))
+	s.True(doc.IsDeleted())
+
+	s.Equal(int64(0), store.MustCount(NewSoftDeleteFixtureQuery()))
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery().OnlyDeleted()))
+
+	_, err := store.FindOne(NewSoftDeleteFixtureQuery().FindByID(doc.ID))
+	s.Equal(kallax.ErrNotFound, err)
+
+	found, err := store.FindOne(NewSoftDeleteFixtureQuery().WithDeleted().FindByID(doc.ID))
+	s.NoError(err)
+	s.True(found.IsDeleted())
+	s.NoError(store.Reload(found))
+
+	s.NoError(store.HardDelete(doc))
+	s.Equal(int64(0), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+}
+
+func (s *StoreSuite) TestSoftDeleteAll() {
+	store := NewSoftDeleteFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b", "c"} {
+		doc := NewSoftDeleteFixture()
+		doc.Foo = foo
+		s.Nil(store.Insert(doc))
+	}
+
+	deleted, err := store.DeleteAll(NewSoftDeleteFixtureQuery().Where(kallax.In(Schema.SoftDeleteFixture.Foo, "a", "b")))
+	s.NoError(err)
+	s.Equal(int64(2), deleted)
+	s.Equal(int64(1), store.MustCount(NewSoftDeleteFixtureQuery()))
+	s.Equal(int64(3), store.MustCount(NewSoftDeleteFixtureQuery().WithDeleted()))
+
+	updated, err := store.UpdateAll(
+		NewSoftDeleteFixtureQuery(),
+		map[kallax.SchemaField]interface{}{Schema.SoftDeleteFixture.Foo: "d"},
+	)
+	s.NoError(err)
+	s.Equal(int64(1), updated)
+}
+
+func (s *StoreSuite) TestSoftDeleteRelationships() {
+	store := NewSoftDeleteFixtureStoreWithDialect(s.db, dialect)
+	doc := NewSoftDeleteFixture()
+	doc.Items = []*SoftDeleteItemFixture{
+		{Name: "foo"},
+		{Name: "bar"},
+	}
+	s.Nil(store.Insert(doc))
+
+	itemStore := NewSoftDeleteItemFixtureStoreWithDialect(s.db, dialect)
+	s.NoError(itemStore.Delete(doc.Items[0]))
+
+	found, err := store.FindOne(NewSoftDeleteFixtureQuery().WithItems(nil))
+	s.NoError(err)
+	s.Len(found.Items, 1)
+	s.Equal("bar", found.Items[0].Name)
+}
+
+func (s *StoreSuite) TestTransactionWith() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	opts := &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}
+	err := store.TransactionWith(opts, func(store *StoreFixtureStore) error {
+		return store.Insert(NewStoreFixture())
+	})
+	s.Error(err)
+	s.Equal(int64(0), store.MustCount(NewStoreFixtureQuery()))
+
+	s.Equal(kallax.ErrInvalidTxCallback, store.TransactionWith(opts, nil))
+}
+
 func (s *StoreSuite) TestFindAliasSlice() {
-	store := NewStoreFixtureStore(s.db)
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
 
 	fixture1 := NewStoreFixture()
 	fixture1.Foo = "ONE"
@@ -238,7 +405,7 @@ func (s *StoreSuite) assertMustFindByFoo(st *StoreFixtureStore, foo string) {
 }
 
 func (s *StoreSuite) TestNullablePtrScan() {
-	store := NewNullableStore(s.db)
+	store := NewNullableStoreWithDialect(s.db, dialect)
 	s.NoError(store.Insert(new(Nullable)))
 	t := time.Now()
 	s.NoError(store.Insert(&Nullable{T: &t}))
@@ -253,3 +420,118 @@ func (s *StoreSuite) TestNullablePtrScan() {
 	s.Nil(records[0].T)
 	s.NotNil(records[1].T)
 }
+
+func (s *StoreSuite) TestFindWithCursor() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b", "c", "d", "e"} {
+		fixture := NewStoreFixture()
+		fixture.Foo = foo
+		s.NoError(store.Insert(fixture))
+	}
+
+	rs, err := store.Find(NewStoreFixtureQuery().
+		Order(kallax.Asc(Schema.StoreFixture.Foo)).
+		BatchSize(2).
+		UseCursor())
+	s.NoError(err)
+
+	fixtures, err := rs.All()
+	s.NoError(err)
+	s.Require().Len(fixtures, 5)
+	s.Equal("a", fixtures[0].Foo)
+	s.Equal("e", fixtures[4].Foo)
+}
+
+func (s *StoreSuite) TestFindPages() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	for _, foo := range []string{"a", "b", "c", "d", "e"} {
+		fixture := NewStoreFixture()
+		fixture.Foo = foo
+		s.NoError(store.Insert(fixture))
+	}
+
+	newQuery := func() *StoreFixtureQuery {
+		return NewStoreFixtureQuery().
+			Order(kallax.Desc(Schema.StoreFixture.Foo)).
+			Limit(2)
+	}
+
+	rs, err := store.Find(newQuery())
+	s.NoError(err)
+	fixtures, err := rs.All()
+	s.NoError(err)
+	s.Require().Len(fixtures, 2)
+	s.Equal("d", fixtures[1].Foo)
+
+	cursor, err := rs.Cursor()
+	s.NoError(err)
+
+	rs, err = store.Find(newQuery().After(cursor))
+	s.NoError(err)
+	fixtures, err = rs.All()
+	s.NoError(err)
+	s.Require().Len(fixtures, 2)
+	s.Equal("c", fixtures[0].Foo)
+	s.Equal("b", fixtures[1].Foo)
+
+	rs, err = store.Find(newQuery().Before(cursor).Limit(1))
+	s.NoError(err)
+	fixtures, err = rs.All()
+	s.NoError(err)
+	s.Require().Len(fixtures, 1)
+	s.Equal("e", fixtures[0].Foo)
+}
+
+func (s *StoreSuite) TestCopyFrom() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	records := make(chan *StoreFixture)
+	go func() {
+		defer close(records)
+		for _, foo := range []string{"ONE", "TWO\tTWO"} {
+			fixture := NewStoreFixture()
+			fixture.Foo = foo
+			fixture.SliceProp = []string{foo, "a,b"}
+			records <- fixture
+		}
+	}()
+
+	n, err := store.CopyFrom(records)
+	s.NoError(err)
+	s.Equal(int64(2), n)
+
+	fixture := store.MustFindOne(NewStoreFixtureQuery().FindByFoo("TWO\tTWO"))
+	s.Equal([]string{"TWO\tTWO", "a,b"}, fixture.SliceProp)
+}
+
+func (s *StoreSuite) TestCopyFromNullable() {
+	store := NewNullableStoreWithDialect(s.db, dialect)
+	t := time.Now().Truncate(time.Microsecond)
+	records := make(chan *Nullable, 2)
+	records <- new(Nullable)
+	records <- &Nullable{T: &t, SomeJSON: &SomeJSON{Foo: 1}}
+	close(records)
+
+	n, err := store.CopyFrom(records)
+	s.NoError(err)
+	s.Equal(int64(2), n)
+
+	all, err := store.FindAll(NewNullableQuery().Order(kallax.Asc(Schema.Nullable.ID)))
+	s.NoError(err)
+	s.Len(all, 2)
+	s.Nil(all[0].T)
+	s.Nil(all[0].SomeJSON)
+	s.True(t.Equal(*all[1].T))
+	s.Equal(&SomeJSON{Foo: 1}, all[1].SomeJSON)
+}
+
+func (s *StoreSuite) TestCopyFromContextCancelled() {
+	store := NewStoreFixtureStoreWithDialect(s.db, dialect)
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make(chan *StoreFixture, 1)
+	records <- NewStoreFixture()
+	cancel()
+
+	_, err := store.CopyFromContext(ctx, records)
+	s.Error(err)
+	s.Equal(int64(0), store.MustCount(NewStoreFixtureQuery()))
+}
@@ -0,0 +1,83 @@
+package kallax
+
+import "context"
+
+// Tracer starts the spans of the operations run by a store, so they can be
+// traced with any tracing library. Spans are started for inserts, updates,
+// finds, counts, transactions and every batch of the queries with 1:N
+// relationships, and they are tagged with:
+//
+//   - "db.operation": the operation of the span.
+//   - "db.table": the table of the operation, if any.
+//   - "db.statement": the first SQL statement run by the operation.
+//   - "db.rows": the number of rows read by finds and batches.
+type Tracer interface {
+	// StartSpan starts a span with the given name as a child of the span in
+	// the given context, if any, and returns it along with a context
+	// carrying it.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetTag sets a tag of the span.
+	SetTag(key string, value interface{})
+	// Finish finishes the span with the error of the operation, if any.
+	Finish(err error)
+}
+
+// WithTracer returns a new store that will trace its operations with the
+// given tracer.
+func (s *Store) WithTracer(tracer Tracer) *Store {
+	store := s.copy()
+	store.tracer = tracer
+	return store.build()
+}
+
+type spanKey struct{}
+
+// storeSpan is the span of a store operation.
+type storeSpan struct {
+	Span
+	// traced reports whether the span has already been tagged with a
+	// statement.
+	traced bool
+}
+
+// startSpan starts the span of the given operation, if there is a tracer,
+// and returns it along with a context carrying the operation and the span.
+func startSpan(ctx context.Context, tracer Tracer, name string, op Operation, table string) (context.Context, Span) {
+	ctx = withOperation(ctx, op, table)
+	if tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	ctx, span := tracer.StartSpan(ctx, name)
+	span.SetTag("db.operation", string(op))
+	if table != "" {
+		span.SetTag("db.table", table)
+	}
+
+	return context.WithValue(ctx, spanKey{}, &storeSpan{Span: span}), span
+}
+
+// startOperation starts the given operation of the store. See startSpan.
+func (s *Store) startOperation(ctx context.Context, op Operation, table string) (context.Context, Span) {
+	return startSpan(ctx, s.tracer, "kallax."+string(op), op, table)
+}
+
+// traceStatement is an interceptor that tags the span in the context of the
+// statement with its SQL, unless it was already tagged.
+func traceStatement(ctx context.Context, stmt *Statement, next StatementHandler) *StatementResult {
+	res := next(ctx, stmt)
+	if span, ok := ctx.Value(spanKey{}).(*storeSpan); ok && !span.traced {
+		span.traced = true
+		span.SetTag("db.statement", stmt.SQL)
+	}
+	return res
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetTag(string, interface{}) {}
+func (noopSpan) Finish(error)               {}
@@ -0,0 +1,100 @@
+package kallax
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+type fakeSpanKey struct{}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	parent, _ := ctx.Value(fakeSpanKey{}).(*fakeSpan)
+	span := &fakeSpan{name: name, parent: parent, tags: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, fakeSpanKey{}, span), span
+}
+
+type fakeSpan struct {
+	name     string
+	parent   *fakeSpan
+	tags     map[string]interface{}
+	finished bool
+	err      error
+}
+
+func (s *fakeSpan) SetTag(key string, value interface{}) {
+	s.tags[key] = value
+}
+
+func (s *fakeSpan) Finish(err error) {
+	s.finished = true
+	s.err = err
+}
+
+func TestStoreWithTracer(t *testing.T) {
+	require := require.New(t)
+
+	tracer := new(fakeTracer)
+	store := (&Store{runner: &fakeProxy{}}).build().WithTracer(tracer)
+
+	ctx, parent := tracer.StartSpan(context.Background(), "parent")
+	_, err := store.CountContext(ctx, NewBaseQuery(ModelSchema))
+	require.Equal(sql.ErrNoRows, err)
+
+	require.Len(tracer.spans, 2)
+	span := tracer.spans[1]
+	require.Equal("kallax.count", span.name)
+	require.Equal(parent, span.parent)
+	require.True(span.finished)
+	require.Equal(sql.ErrNoRows, span.err)
+	require.Equal(map[string]interface{}{
+		"db.operation": "count",
+		"db.table":     "model",
+		"db.statement": "SELECT COUNT(id) FROM model __model",
+	}, span.tags)
+}
+
+func TestStoreWithTracer_Transaction(t *testing.T) {
+	require := require.New(t)
+
+	tracer := new(fakeTracer)
+	store := (&Store{runner: &fakeProxy{}}).build().WithTracer(tracer)
+
+	err := store.Transaction(func(s *Store) error {
+		return nil
+	})
+	require.NoError(err)
+
+	require.Len(tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal("kallax.transaction", span.name)
+	require.True(span.finished)
+	require.NoError(span.err)
+	require.Equal(map[string]interface{}{
+		"db.operation": "transaction",
+		"db.statement": "SAVEPOINT kallax_savepoint_1",
+	}, span.tags)
+}
+
+func TestStartSpan(t *testing.T) {
+	require := require.New(t)
+
+	ctx, span := startSpan(context.Background(), nil, "foo", FindOperation, "bar")
+	require.Equal(noopSpan{}, span)
+	require.Equal(operation{FindOperation, "bar"}, operationFromContext(ctx))
+	require.Nil(ctx.Value(spanKey{}))
+
+	tracer := new(fakeTracer)
+	ctx, span = startSpan(context.Background(), tracer, "foo", RawOperation, "")
+	require.Equal(tracer.spans[0], span)
+	require.Equal(map[string]interface{}{"db.operation": "raw"}, tracer.spans[0].tags)
+	require.Equal(operation{RawOperation, ""}, operationFromContext(ctx))
+	require.NotNil(ctx.Value(spanKey{}))
+}
@@ -772,6 +772,129 @@ func (a Float32Array) Value() (driver.Value, error) {
 	return "{}", nil
 }
 
+// JSONArray returns an SQLType that converts the slice or array wrapped by
+// the given value, as returned by Slice or Array, to and from a JSON array
+// instead of a PostgreSQL array, for databases without arrays. It reports
+// whether the value was one of them.
+// Elements are encoded with encoding/json, so the elements of slices of types
+// implementing sql.Scanner and driver.Valuer are not converted with them.
+func JSONArray(v interface{}) (SQLType, bool) {
+	switch v := v.(type) {
+	case *Uint64Array, *IntArray, *UintArray, *Int32Array, *Uint32Array,
+		*Int16Array, *Uint16Array, *Int8Array, *Float32Array:
+		return JSON(v), true
+	case *Uint8Array:
+		// []uint8 would be encoded as a base64 string
+		return &jsonUint8Array{v}, true
+	case *slice:
+		switch v.val.(type) {
+		case *[]url.URL, *[]*url.URL, []*url.URL:
+			return &jsonURLArray{v.val}, true
+		}
+		return JSON(v.val), true
+	case *array:
+		return JSON(v.val.Interface()), true
+	}
+
+	return nil, false
+}
+
+type jsonUint8Array struct {
+	val *Uint8Array
+}
+
+func (a *jsonUint8Array) Scan(v interface{}) error {
+	var elems []uint16
+	if err := JSON(&elems).Scan(v); err != nil {
+		return err
+	}
+
+	if elems == nil {
+		*a.val = nil
+		return nil
+	}
+
+	var res = make(Uint8Array, len(elems))
+	for i, e := range elems {
+		if e > 255 {
+			return fmt.Errorf("kallax: unable to scan %d into uint8", e)
+		}
+		res[i] = uint8(e)
+	}
+	*a.val = res
+	return nil
+}
+
+func (a *jsonUint8Array) Value() (driver.Value, error) {
+	if *a.val == nil {
+		return JSON(nil).Value()
+	}
+
+	var elems = make([]uint16, len(*a.val))
+	for i, e := range *a.val {
+		elems[i] = uint16(e)
+	}
+	return JSON(elems).Value()
+}
+
+type jsonURLArray struct {
+	val interface{}
+}
+
+func (a *jsonURLArray) Scan(v interface{}) error {
+	var s []string
+	if err := JSON(&s).Scan(v); err != nil {
+		return err
+	}
+
+	var urls = make([]*url.URL, len(s))
+	for i, str := range s {
+		u, err := url.Parse(str)
+		if err != nil {
+			return fmt.Errorf("kallax: error scanning url: %s", err)
+		}
+		urls[i] = u
+	}
+
+	switch o := a.val.(type) {
+	case *[]url.URL:
+		var res = make([]url.URL, len(urls))
+		for i, u := range urls {
+			res[i] = *u
+		}
+		*o = res
+	case *[]*url.URL:
+		*o = urls
+	default:
+		return fmt.Errorf("kallax: cannot scan JSON array into type %T", a.val)
+	}
+
+	return nil
+}
+
+func (a *jsonURLArray) Value() (driver.Value, error) {
+	var s []string
+	switch v := a.val.(type) {
+	case *[]url.URL:
+		if *v != nil {
+			s = make([]string, len(*v))
+		}
+		for i, u := range *v {
+			s[i] = (&u).String()
+		}
+	case *[]*url.URL:
+		return (&jsonURLArray{*v}).Value()
+	case []*url.URL:
+		if v != nil {
+			s = make([]string, len(v))
+		}
+		for i, u := range v {
+			s[i] = u.String()
+		}
+	}
+	return JSON(s).Value()
+}
+
 // parseArray extracts the dimensions and elements of an array represented in
 // text format. Only representations emitted by the backend are supported.
 // Notably, whitespace around brackets and delimiters is significant, and NULL
@@ -108,6 +108,81 @@ func TestSlice(t *testing.T) {
 	}
 }
 
+func TestJSONArray(t *testing.T) {
+	require := require.New(t)
+
+	var arr [3]int64
+	cases := []struct {
+		v        SQLType
+		expected string
+		dest     SQLType
+	}{
+		{
+			Slice(&([]url.URL{mustURL("https://foo.com"), mustURL("http://foo.foo")})),
+			`["https://foo.com","http://foo.foo"]`,
+			Slice(&([]url.URL{})),
+		},
+		{
+			Slice(&([]*url.URL{mustPtrURL("https://foo.com")})),
+			`["https://foo.com"]`,
+			Slice(&([]*url.URL{})),
+		},
+		{
+			Slice(&([]string{"a", "b"})),
+			`["a","b"]`,
+			Slice(&([]string{})),
+		},
+		{
+			Slice(&([]int{123, 321, 333})),
+			`[123,321,333]`,
+			Slice(&([]int{})),
+		},
+		{
+			Slice(&([]uint8{1, 3, 255})),
+			`[1,3,255]`,
+			Slice(&([]uint8{})),
+		},
+		{
+			Slice(&([]float32{1., .5})),
+			`[1,0.5]`,
+			Slice(&([]float32{})),
+		},
+		{
+			Array(&([3]int64{1, 2, 3}), 3),
+			`[1,2,3]`,
+			Array(&arr, 3),
+		},
+	}
+
+	for _, c := range cases {
+		src, ok := JSONArray(c.v)
+		require.True(ok)
+		val, err := src.Value()
+		require.NoError(err)
+		require.Equal(c.expected, string(val.([]byte)))
+
+		dest, ok := JSONArray(c.dest)
+		require.True(ok)
+		require.NoError(dest.Scan(val))
+
+		destVal, err := c.dest.Value()
+		require.NoError(err)
+		srcVal, err := c.v.Value()
+		require.NoError(err)
+		require.Equal(srcVal, destVal)
+	}
+
+	var nilSlice []string
+	src, ok := JSONArray(Slice(&nilSlice))
+	require.True(ok)
+	val, err := src.Value()
+	require.NoError(err)
+	require.Equal("null", string(val.([]byte)))
+
+	_, ok = JSONArray(JSON(&nilSlice))
+	require.False(ok)
+}
+
 func TestSlice_Integration(t *testing.T) {
 	s := require.New(t)
 	cases := []struct {
@@ -810,3 +810,36 @@ func (j *sqlJSON) Scan(v interface{}) error {
 func (j *sqlJSON) Value() (driver.Value, error) {
 	return json.Marshal(j.val)
 }
+
+// IsJSON reports whether the given value was returned by JSON.
+func IsJSON(v interface{}) bool {
+	_, ok := v.(*sqlJSON)
+	return ok
+}
+
+// Unwrap returns the value wrapped by the given value returned by Slice or
+// JSON, for drivers with their own array and JSON codecs that can decode
+// directly into it, and reports whether it was unwrapped. Only pointers to
+// slices of string, int64, float64 and bool are unwrapped from Slice, and
+// pointers to strings and byte slices are never unwrapped from JSON, since
+// drivers would not decode JSON into them.
+func Unwrap(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case *slice:
+		switch v.val.(type) {
+		case *[]string, *[]int64, *[]float64, *[]bool:
+			return v.val, true
+		}
+	case *sqlJSON:
+		switch v.val.(type) {
+		case *string, *[]byte:
+			return nil, false
+		}
+
+		if t := reflect.TypeOf(v.val); t != nil && t.Kind() == reflect.Ptr {
+			return v.val, true
+		}
+	}
+
+	return nil, false
+}
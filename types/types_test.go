@@ -83,6 +83,40 @@ func TestJSON(t *testing.T) {
 	})
 }
 
+func TestIsJSON(t *testing.T) {
+	require := require.New(t)
+	require.True(IsJSON(JSON(map[string]int{})))
+	require.False(IsJSON(Slice([]string{})))
+	require.False(IsJSON("foo"))
+}
+
+func TestUnwrap(t *testing.T) {
+	require := require.New(t)
+	var (
+		strs []string
+		ints []int
+		m    map[string]int
+		str  string
+	)
+
+	v, ok := Unwrap(Slice(&strs))
+	require.True(ok)
+	require.Equal(&strs, v)
+
+	v, ok = Unwrap(JSON(&m))
+	require.True(ok)
+	require.Equal(&m, v)
+
+	_, ok = Unwrap(Slice(&ints))
+	require.False(ok)
+	_, ok = Unwrap(JSON(&str))
+	require.False(ok)
+	_, ok = Unwrap(JSON(m))
+	require.False(ok)
+	_, ok = Unwrap(&strs)
+	require.False(ok)
+}
+
 func TestArray(t *testing.T) {
 	require := require.New(t)
 	input, err := pq.Array([]int64{1, 2}).Value()